@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterReplicationPolicySpec defines the desired state of ClusterReplicationPolicy
+type ClusterReplicationPolicySpec struct {
+	// SourceNamespaces selects the namespaces which are allowed to publish replicas
+	// +optional
+	SourceNamespaces NamespaceSelection `json:"sourceNamespaces,omitempty"`
+
+	// AllowedKinds lists the kinds which the source namespaces are allowed to publish.
+	// All kinds are allowed if not specified.
+	// +optional
+	AllowedKinds []string `json:"allowedKinds,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=crp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterReplicationPolicy allows namespaces to publish replicas of objects. Once at least one cluster
+// replication policy is present, sources are only replicated if a cluster replication policy allows it.
+type ClusterReplicationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterReplicationPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterReplicationPolicyList contains a list of ClusterReplicationPolicy
+type ClusterReplicationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterReplicationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterReplicationPolicy{}, &ClusterReplicationPolicyList{})
+}
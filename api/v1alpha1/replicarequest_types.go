@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicaRequestConditionReady indicates whether the requested source was replicated into the namespace
+const ReplicaRequestConditionReady = "Ready"
+
+// ReplicaRequestSpec defines the source object requested to be replicated into the namespace
+type ReplicaRequestSpec struct {
+	// Kind of the source object (e.g. Secret)
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// SourceNamespace is the namespace of the source object
+	// +kubebuilder:validation:MinLength=1
+	SourceNamespace string `json:"sourceNamespace"`
+
+	// Name of the source object
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// ReplicaRequestStatus defines the observed state of ReplicaRequest
+type ReplicaRequestStatus struct {
+	// ObservedGeneration is the most recent generation of the replica request which was reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the replica request's state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rr
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.kind`
+// +kubebuilder:printcolumn:name="Source Namespace",type=string,JSONPath=`.spec.sourceNamespace`
+// +kubebuilder:printcolumn:name="Source Name",type=string,JSONPath=`.spec.name`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReplicaRequest requests a source object which allows requests to be replicated into the namespace
+// of the request. The replica is removed when the request is deleted.
+type ReplicaRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicaRequestSpec   `json:"spec,omitempty"`
+	Status ReplicaRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicaRequestList contains a list of ReplicaRequest
+type ReplicaRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicaRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicaRequest{}, &ReplicaRequestList{})
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicatedKindConditionReady indicates whether the controller replicating the kind is running
+const ReplicatedKindConditionReady = "Ready"
+
+// +kubebuilder:validation:XValidation:rule="self.kind == oldSelf.kind && (has(self.group) ? self.group : '') == (has(oldSelf.group) ? oldSelf.group : '')",message="group and kind are immutable"
+
+// ReplicatedKindSpec defines the resource kind to be replicated
+type ReplicatedKindSpec struct {
+	// Group of the resource kind (empty for the core API group)
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version of the resource kind
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// Kind of the resource
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Fields are the top-level fields copied from the source objects (e.g. spec, data)
+	// +kubebuilder:validation:MinItems=1
+	Fields []string `json:"fields"`
+}
+
+// ReplicatedKindStatus defines the observed state of ReplicatedKind
+type ReplicatedKindStatus struct {
+	// ObservedGeneration is the most recent generation of the replicated kind which was reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the replicated kind's state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=rk
+// +kubebuilder:printcolumn:name="Group",type=string,JSONPath=`.spec.group`
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.kind`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReplicatedKind adds a resource kind to be replicated by the operator at runtime. A controller for
+// the kind is started when the replicated kind is created, and stopped when it is deleted.
+type ReplicatedKind struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicatedKindSpec   `json:"spec,omitempty"`
+	Status ReplicatedKindStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicatedKindList contains a list of ReplicatedKind
+type ReplicatedKindList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicatedKind `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicatedKind{}, &ReplicatedKindList{})
+}
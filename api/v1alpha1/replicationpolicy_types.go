@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeletionPolicy defines what happens to the replicas once they are no longer managed by a policy
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the replicas
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain keeps the replicas as unmanaged objects in the target namespaces
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// ConflictPolicy defines how existing objects not managed by the replicator are handled when
+// replicating into a namespace
+// +kubebuilder:validation:Enum=Reject;Adopt
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyReject leaves the existing object untouched and reports a conflict
+	ConflictPolicyReject ConflictPolicy = "Reject"
+	// ConflictPolicyAdopt overwrites the existing object and manages it as a replica, unless the object
+	// is managed by the replicator (such as another source)
+	ConflictPolicyAdopt ConflictPolicy = "Adopt"
+)
+
+// ReplicationPolicyConditionReady indicates whether all the selected sources were replicated
+const ReplicationPolicyConditionReady = "Ready"
+
+// SourceSelector selects objects of a kind in the namespace of the policy
+type SourceSelector struct {
+	// Kind of the source objects (e.g. Secret, ConfigMap)
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Name of the source object. Either name or selector should be specified.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Selector selects the source objects using their labels. Either name or selector should be specified.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// IncludeKeys limits the replicated data keys to the listed keys or glob patterns. Only
+	// supported for kinds holding keyed data (Secret and ConfigMap).
+	// +optional
+	IncludeKeys []string `json:"includeKeys,omitempty"`
+
+	// ExcludeKeys lists the data keys or glob patterns which are never replicated. Only supported
+	// for kinds holding keyed data (Secret and ConfigMap).
+	// +optional
+	ExcludeKeys []string `json:"excludeKeys,omitempty"`
+
+	// Transforms are applied in order to the replicas of the source objects (after the transforms
+	// requested by the source objects themselves)
+	// +optional
+	Transforms []Transform `json:"transforms,omitempty"`
+}
+
+// Transform specifies a transform applied to the replicas and its configuration
+type Transform struct {
+	// Type of the transform (e.g. renameKeys, template, base64, mergeKeys)
+	Type string `json:"type"`
+
+	// Config is the configuration of the transform
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// NamespaceSelection selects namespaces using their labels and names. A namespace needs to
+// satisfy all the specified rules to be selected. All namespaces are selected if no rules
+// are specified.
+type NamespaceSelection struct {
+	// NamespaceSelector selects the namespaces using their labels
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Namespaces is a list of namespace names or glob patterns (e.g. team-*). Entries prefixed
+	// with "!" exclude the matching namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// ReplicationOptions controls how the replicas of the sources are managed
+type ReplicationOptions struct {
+	// DeletionPolicy defines what happens to the replicas when the policy is deleted
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// ConflictPolicy defines how existing objects with the same name in the target namespaces are handled
+	// +kubebuilder:default=Reject
+	// +optional
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// ReplicationPolicySpec defines the desired state of ReplicationPolicy
+type ReplicationPolicySpec struct {
+	// Sources selects the objects in the namespace of the policy which are replicated
+	// +kubebuilder:validation:MinItems=1
+	Sources []SourceSelector `json:"sources"`
+
+	// Targets selects the namespaces into which the sources are replicated
+	// +optional
+	Targets NamespaceSelection `json:"targets,omitempty"`
+
+	// Options controls how the replicas are managed
+	// +optional
+	Options ReplicationOptions `json:"options,omitempty"`
+}
+
+// ReplicationPolicyStatus defines the observed state of ReplicationPolicy
+type ReplicationPolicyStatus struct {
+	// ObservedGeneration is the most recent generation of the policy which was reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the policy's state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=rp
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReplicationPolicy declares objects in its namespace to be replicated into other namespaces
+type ReplicationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationPolicySpec   `json:"spec,omitempty"`
+	Status ReplicationPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationPolicyList contains a list of ReplicationPolicy
+type ReplicationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationPolicy{}, &ReplicationPolicyList{})
+}
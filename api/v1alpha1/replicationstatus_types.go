@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicaState is the state of the replica of a source object in a target namespace
+// +kubebuilder:validation:Enum=Pending;Synced;Failed;Conflict
+type ReplicaState string
+
+const (
+	// ReplicaStatePending indicates that the replica failed to sync, and is queued to be synced again
+	ReplicaStatePending ReplicaState = "Pending"
+	// ReplicaStateSynced indicates that the replica is in sync with the source object
+	ReplicaStateSynced ReplicaState = "Synced"
+	// ReplicaStateFailed indicates that the replica could not be synced with the source object
+	ReplicaStateFailed ReplicaState = "Failed"
+	// ReplicaStateConflict indicates that an object not managed by the replicator prevents creating the replica
+	ReplicaStateConflict ReplicaState = "Conflict"
+)
+
+// SourceReference refers to a source object in the namespace of the replication status
+type SourceReference struct {
+	// Kind of the source object
+	Kind string `json:"kind"`
+
+	// Name of the source object
+	Name string `json:"name"`
+}
+
+// ReplicaStatus is the replication state of the source object in a target namespace
+type ReplicaStatus struct {
+	// Namespace is the target namespace of the replica
+	Namespace string `json:"namespace"`
+
+	// State of the replica
+	State ReplicaState `json:"state"`
+
+	// Reason explains the state of the replica if it is not synced
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// LastSyncTime is the time at which the replica was last synced with a new version of the source object
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// SourceResourceVersion is the resource version of the source object last synced into the replica
+	// +optional
+	SourceResourceVersion string `json:"sourceResourceVersion,omitempty"`
+}
+
+// ReplicationStatusSpec defines the source object of which the replication is reported
+type ReplicationStatusSpec struct {
+	// Source is the object being replicated
+	Source SourceReference `json:"source"`
+}
+
+// ReplicationStatusStatus defines the observed replication state of the source object
+type ReplicationStatusStatus struct {
+	// Replicas lists the state of the replica in each target namespace
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	Replicas []ReplicaStatus `json:"replicas,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=replstatus
+// +kubebuilder:printcolumn:name="Source Kind",type=string,JSONPath=`.spec.source.kind`
+// +kubebuilder:printcolumn:name="Source Name",type=string,JSONPath=`.spec.source.name`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ReplicationStatus reports where a source object was replicated into, and whether it succeeded.
+// It is maintained by the operator in the namespace of the source object.
+type ReplicationStatus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReplicationStatusSpec   `json:"spec,omitempty"`
+	Status ReplicationStatusStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationStatusList contains a list of ReplicationStatus
+type ReplicationStatusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationStatus `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationStatus{}, &ReplicationStatusList{})
+}
@@ -14,22 +14,33 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
 	"github.com/nadundesilva/k8s-replicator/controllers"
 	"github.com/nadundesilva/k8s-replicator/controllers/replication"
 	//+kubebuilder:scaffold:imports
@@ -45,6 +56,7 @@ func init() {
 	for _, replicator := range replicators {
 		utilruntime.Must(replicator.AddToScheme(scheme))
 	}
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
 
 	//+kubebuilder:scaffold:scheme
 }
@@ -55,6 +67,14 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var replicatorsConfigFile string
+	var remoteClustersNamespace string
+	var namespaceOptIn bool
+	var excludedNamespaces string
+	var otlpEndpoint string
+	var namespaceParallelism int
+	var cacheMarkedObjectsOnly bool
+	var requireClusterReplicationPolicy bool
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -64,6 +84,30 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&replicatorsConfigFile, "replicators-config", "",
+		"Path to a config file listing additional resource kinds to be replicated.")
+	flag.StringVar(&remoteClustersNamespace, "remote-clusters-namespace", "",
+		"The namespace of the kubeconfig Secrets defining the remote clusters sources can be replicated into. "+
+			"Replicating into remote clusters is disabled if empty.")
+	flag.BoolVar(&namespaceOptIn, "namespace-opt-in", false,
+		"If set, sources are only replicated into namespaces labeled as managed, "+
+			"instead of all the namespaces which are not ignored.")
+	flag.StringVar(&excludedNamespaces, "excluded-namespaces", "kube-*",
+		"Comma-separated glob patterns of the system namespaces which are not replicated into "+
+			"unless labeled as managed (e.g. kube-*,openshift-*,istio-system).")
+	flag.IntVar(&namespaceParallelism, "namespace-parallelism", controllers.DefaultNamespaceParallelism,
+		"The maximum number of namespaces a single reconcile replicates into at once.")
+	flag.BoolVar(&cacheMarkedObjectsOnly, "cache-marked-objects-only", false,
+		"If set, only the objects of the replicated kinds marked using the object type label are cached, "+
+			"reducing the memory used in clusters with many objects of the replicated kinds. "+
+			"The objects which are not marked (e.g. sources selected by replication policies) are read "+
+			"using the API server instead.")
+	flag.BoolVar(&requireClusterReplicationPolicy, "require-cluster-replication-policy", false,
+		"If set, sources are only replicated if allowed by a ClusterReplicationPolicy, "+
+			"instead of replicating all the sources while there are no ClusterReplicationPolicies.")
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "",
+		"The OTLP/HTTP endpoint the traces are exported to (e.g. http://otel-collector:4318). "+
+			"Tracing is disabled if not set.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -71,6 +115,51 @@ func main() {
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	controllers.SetNamespaceOptIn(namespaceOptIn)
+	controllers.SetCacheMarkedObjectsOnly(cacheMarkedObjectsOnly)
+	controllers.SetClusterReplicationPolicyRequired(requireClusterReplicationPolicy)
+	if err := controllers.SetExcludedNamespaces(strings.Split(excludedNamespaces, ",")); err != nil {
+		setupLog.Error(err, "unable to configure excluded namespaces")
+		os.Exit(1)
+	}
+	if err := controllers.SetNamespaceParallelism(namespaceParallelism); err != nil {
+		setupLog.Error(err, "unable to configure namespace parallelism")
+		os.Exit(1)
+	}
+
+	var tracerProvider *sdktrace.TracerProvider
+	if otlpEndpoint != "" {
+		var err error
+		tracerProvider, err = controllers.NewTracerProvider(context.Background(), otlpEndpoint)
+		if err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+		otel.SetTracerProvider(tracerProvider)
+		setupLog.Info("exporting traces", "endpoint", otlpEndpoint)
+	}
+
+	restConfig := ctrl.GetConfigOrDie()
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to create discovery client")
+		os.Exit(1)
+	}
+	optionalReplicators := replication.NewOptionalReplicators(discoveryClient, setupLog)
+	for _, replicator := range optionalReplicators {
+		utilruntime.Must(replicator.AddToScheme(scheme))
+		setupLog.Info("replicating kind served by the cluster", "kind", replicator.GetKind())
+	}
+	replicators = append(replicators, optionalReplicators...)
+
+	if replicatorsConfigFile != "" {
+		configuredReplicators, err := replication.LoadReplicators(replicatorsConfigFile, replicators)
+		if err != nil {
+			setupLog.Error(err, "unable to load replicators config")
+			os.Exit(1)
+		}
+		replicators = append(replicators, configuredReplicators...)
+	}
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -88,11 +177,25 @@ func main() {
 		tlsOpts = append(tlsOpts, disableHTTP2)
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme: scheme,
+		NewClient: func(config *rest.Config, options client.Options) (client.Client, error) {
+			k8sClient, err := client.New(config, options)
+			if err != nil || tracerProvider == nil {
+				return k8sClient, err
+			}
+			return controllers.NewTracingClient(k8sClient), nil
+		},
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// The replicated kinds which are not registered in the scheme are read from the cache as well
+				Unstructured: true,
+			},
+		},
 		Cache: cache.Options{
 			Scheme:                      scheme,
 			ReaderFailOnMissingInformer: true,
+			ByObject:                    controllers.NewCacheByObject(replicators, remoteClustersNamespace),
 		},
 		Metrics: metricsserver.Options{
 			BindAddress:   metricsAddr,
@@ -119,22 +222,77 @@ func main() {
 		os.Exit(1)
 	}
 
+	var remoteClusters *controllers.RemoteClusterRegistry
+	if remoteClustersNamespace != "" {
+		remoteClusters = controllers.NewRemoteClusterRegistry(remoteClustersNamespace)
+	}
 	for _, replicator := range replicators {
+		objectGVK, err := apiutil.GVKForObject(replicator.EmptyObject(), scheme)
+		if err != nil {
+			setupLog.Error(err, "unable to identify replicated kind", "kind", replicator.GetKind())
+			os.Exit(1)
+		}
+		mapping, err := mgr.GetRESTMapper().RESTMapping(objectGVK.GroupKind(), objectGVK.Version)
+		if err != nil {
+			setupLog.Error(err, "unable to find replicated kind in the cluster", "kind", replicator.GetKind())
+			os.Exit(1)
+		}
+		if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+			setupLog.Error(nil, "unable to replicate cluster scoped kind", "kind", replicator.GetKind())
+			os.Exit(1)
+		}
+
 		if err = (&controllers.ReplicationReconciler{
-			Replicator: replicator,
+			Replicator:     replicator,
+			RemoteClusters: remoteClusters,
 		}).SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to create controller", "kind", replicator.GetKind())
 			os.Exit(1)
 		}
 	}
+	registeredReplicators := controllers.NewReplicatorRegistry()
 	if err = (&controllers.NamespaceReconciler{
-		Replicators: replicators,
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "kind", "Namespace")
 		os.Exit(1)
 	}
+	policyReconciler := &controllers.ReplicationPolicyReconciler{
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
+	}
+	if err = policyReconciler.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "kind", "ReplicationPolicy")
+		os.Exit(1)
+	}
+	if err = (&controllers.ReplicatedKindReconciler{
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
+		PolicyReconciler:      policyReconciler,
+		RemoteClusters:        remoteClusters,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "kind", "ReplicatedKind")
+		os.Exit(1)
+	}
+	if remoteClusters != nil {
+		if err = (&controllers.RemoteClusterReconciler{
+			Replicators:           replicators,
+			RegisteredReplicators: registeredReplicators,
+			RemoteClusters:        remoteClusters,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "kind", "RemoteCluster")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
+	if err := metrics.Registry.Register(controllers.NewReplicationCollector(mgr.GetClient(), replicators,
+		registeredReplicators)); err != nil {
+		setupLog.Error(err, "unable to register replication metrics")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -149,4 +307,10 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+	if tracerProvider != nil {
+		if err := tracerProvider.Shutdown(context.Background()); err != nil {
+			setupLog.Error(err, "problem flushing traces")
+			os.Exit(1)
+		}
+	}
 }
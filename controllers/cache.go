@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+)
+
+const (
+	// replicaSourceIndexField indexes the replicas by the namespaced name of their source objects
+	replicaSourceIndexField = groupFqn + "/source"
+	// objectTypeIndexField indexes the objects by whether they are source objects or replicas
+	objectTypeIndexField = groupFqn + "/object-type"
+	// replicationPolicyIndexField indexes the replicas by the namespaced name of the policies managing them
+	replicationPolicyIndexField = groupFqn + "/replication-policy"
+
+	objectTypeIndexValueSource  = "source"
+	objectTypeIndexValueReplica = "replica"
+)
+
+// setupFieldIndexes adds the field indexes used for looking up the source objects and replicas of a kind
+// in the cache, instead of filtering all the cached objects of the kind using labels
+func setupFieldIndexes(ctx context.Context, indexer client.FieldIndexer, object client.Object) error {
+	if err := indexer.IndexField(ctx, object, replicaSourceIndexField, indexReplicaSource); err != nil {
+		return fmt.Errorf("failed to index replicas by source object: %+w", err)
+	}
+	if err := indexer.IndexField(ctx, object, objectTypeIndexField, indexObjectType); err != nil {
+		return fmt.Errorf("failed to index objects by object type: %+w", err)
+	}
+	if err := indexer.IndexField(ctx, object, replicationPolicyIndexField, indexReplicationPolicy); err != nil {
+		return fmt.Errorf("failed to index replicas by replication policy: %+w", err)
+	}
+	return nil
+}
+
+func indexReplicaSource(object client.Object) []string {
+	if !isReplica(object) {
+		return nil
+	}
+	sourceNamespace, ok := object.GetAnnotations()[sourceNamespaceAnnotationKey]
+	if !ok {
+		return nil
+	}
+	return []string{replicaSourceIndexValue(sourceNamespace, getReplicaSourceName(object))}
+}
+
+func replicaSourceIndexValue(sourceNamespace string, sourceName string) string {
+	return sourceNamespace + "/" + sourceName
+}
+
+func indexReplicationPolicy(object client.Object) []string {
+	if !isReplica(object) {
+		return nil
+	}
+	policyName, ok := object.GetAnnotations()[replicationPolicyAnnotationKey]
+	if !ok {
+		return nil
+	}
+	return []string{replicationPolicyIndexValue(object.GetAnnotations()[sourceNamespaceAnnotationKey], policyName)}
+}
+
+func replicationPolicyIndexValue(policyNamespace string, policyName string) string {
+	return policyNamespace + "/" + policyName
+}
+
+func indexObjectType(object client.Object) []string {
+	switch object.GetLabels()[objectTypeLabelKey] {
+	case objectTypeLabelValueReplicated, objectTypeLabelValueRequestable:
+		return []string{objectTypeIndexValueSource}
+	case objectTypeLabelValueReplica:
+		return []string{objectTypeIndexValueReplica}
+	default:
+		return nil
+	}
+}
+
+// NewCacheByObject creates the cache configuration limiting the cached objects of the replicated kinds
+// to the objects marked using the object type label. Nil is returned (caching all the objects) unless
+// caching only the marked objects is enabled. The kubeconfig Secrets of the remote clusters are not
+// marked, and hence all the Secrets in the remote clusters namespace are cached.
+func NewCacheByObject(replicators []replication.Replicator, remoteClustersNamespace string) map[client.Object]cache.ByObject {
+	if !cacheMarkedObjectsOnly {
+		return nil
+	}
+	markedObjectsReq, err := labels.NewRequirement(objectTypeLabelKey, selection.Exists, nil)
+	if err != nil {
+		panic(fmt.Errorf("failed to initialize marked objects selector %+w", err))
+	}
+	markedObjectsSelector := labels.NewSelector().Add(*markedObjectsReq)
+
+	byObject := map[client.Object]cache.ByObject{}
+	for _, replicator := range replicators {
+		object := replicator.EmptyObject()
+		if _, isSecret := object.(*corev1.Secret); isSecret && remoteClustersNamespace != "" {
+			byObject[object] = cache.ByObject{
+				Namespaces: map[string]cache.Config{
+					remoteClustersNamespace: {},
+					cache.AllNamespaces: {
+						LabelSelector: markedObjectsSelector,
+					},
+				},
+			}
+		} else {
+			byObject[object] = cache.ByObject{
+				Label: markedObjectsSelector,
+			}
+		}
+	}
+	return byObject
+}
+
+// liveReadClient reads the objects using the API server instead of the cache, which does not hold the
+// objects which are not marked when caching only the marked objects
+type liveReadClient struct {
+	client.Client
+	apiReader client.Reader
+}
+
+func (c *liveReadClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.apiReader.Get(ctx, key, obj, opts...)
+}
+
+func (c *liveReadClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.apiReader.List(ctx, list, opts...)
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Replication Cache", func() {
+	var sourceNamespace *corev1.Namespace
+	var clustersNamespace *corev1.Namespace
+	var markedObjectsCache cache.Cache
+	var stopCache context.CancelFunc
+	nc := namespaceCreator{}
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+		clustersNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "clusters-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, clustersNamespace)).To(Succeed())
+
+		SetCacheMarkedObjectsOnly(true)
+		byObject := NewCacheByObject(replication.NewReplicators(), clustersNamespace.GetName())
+		SetCacheMarkedObjectsOnly(false)
+
+		var err error
+		markedObjectsCache, err = cache.New(cfg, cache.Options{
+			Scheme:   k8sClient.Scheme(),
+			ByObject: byObject,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(setupFieldIndexes(ctx, markedObjectsCache, &corev1.ConfigMap{})).To(Succeed())
+		Expect(setupFieldIndexes(ctx, markedObjectsCache, &corev1.Secret{})).To(Succeed())
+
+		var cacheCtx context.Context
+		cacheCtx, stopCache = context.WithCancel(context.Background())
+		go func() {
+			defer GinkgoRecover()
+			Expect(markedObjectsCache.Start(cacheCtx)).To(Succeed())
+		}()
+		Expect(markedObjectsCache.WaitForCacheSync(ctx)).To(BeTrue())
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		stopCache()
+		markedObjectsCache = nil
+
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+		deleteNamespace(ctx, clustersNamespace)
+		clustersNamespace = nil
+
+		nc.Cleanup(ctx)
+	})
+
+	It("Should look up the replicas of a source object using the index", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+		sourceObject := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-configmap",
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			Data: map[string]string{
+				"test-key": "test-value",
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+		Eventually(func() int {
+			replicaList := &corev1.ConfigMapList{}
+			err := markedObjectsCache.List(ctx, replicaList, client.MatchingFields{
+				replicaSourceIndexField: replicaSourceIndexValue(sourceObject.GetNamespace(), sourceObject.GetName()),
+			})
+			if err != nil {
+				return -1
+			}
+			return len(replicaList.Items)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(len(targetNamespaces)))
+
+		sourceList := &corev1.ConfigMapList{}
+		Expect(markedObjectsCache.List(ctx, sourceList, client.InNamespace(sourceNamespace.GetName()),
+			client.MatchingFields{objectTypeIndexField: objectTypeIndexValueSource})).To(Succeed())
+		Expect(sourceList.Items).To(HaveLen(1))
+		Expect(sourceList.Items[0].GetName()).To(Equal(sourceObject.GetName()))
+	}, testTimeout)
+
+	It("Should not cache the objects which are not marked", func(ctx SpecContext) {
+		unmarkedObject := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-unmarked-secret",
+				Namespace: sourceNamespace.GetName(),
+			},
+			StringData: map[string]string{
+				"test-key": "test-value",
+			},
+		}
+		Expect(k8sClient.Create(ctx, unmarkedObject)).To(Succeed())
+		markedObject := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-marked-secret",
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			StringData: map[string]string{
+				"test-key": "test-value",
+			},
+		}
+		Expect(k8sClient.Create(ctx, markedObject)).To(Succeed())
+		clusterSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-cluster-secret",
+				Namespace: clustersNamespace.GetName(),
+			},
+			StringData: map[string]string{
+				"test-key": "test-value",
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterSecret)).To(Succeed())
+
+		Eventually(func() error {
+			return markedObjectsCache.Get(ctx, client.ObjectKeyFromObject(markedObject), &corev1.Secret{})
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		Eventually(func() error {
+			return markedObjectsCache.Get(ctx, client.ObjectKeyFromObject(clusterSecret), &corev1.Secret{})
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		Consistently(func() bool {
+			err := markedObjectsCache.Get(ctx, client.ObjectKeyFromObject(unmarkedObject), &corev1.Secret{})
+			return errors.IsNotFound(err)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+	}, testTimeout)
+})
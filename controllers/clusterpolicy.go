@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=clusterreplicationpolicies,verbs=get;list;watch
+
+// isSourceAllowed checks whether the cluster replication policies allow publishing replicas of objects
+// of the kind from the namespace. All sources are allowed if there are no cluster replication policies,
+// unless requiring a cluster replication policy is enabled.
+func isSourceAllowed(ctx context.Context, k8sClient client.Client, kind string, namespace string) (bool, error) {
+	policyList := &v1alpha1.ClusterReplicationPolicyList{}
+	err := k8sClient.List(ctx, policyList)
+	if err != nil {
+		return false, fmt.Errorf("failed to list cluster replication policies: %+w", err)
+	}
+	if len(policyList.Items) == 0 {
+		return !clusterReplicationPolicyRequired, nil
+	}
+
+	ns := &corev1.Namespace{}
+	err = k8sClient.Get(ctx, client.ObjectKey{Name: namespace}, ns)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get source namespace %s: %+w", namespace, err)
+	}
+
+	for _, policy := range policyList.Items {
+		if len(policy.Spec.AllowedKinds) > 0 && !slices.Contains(policy.Spec.AllowedKinds, kind) {
+			continue
+		}
+		matcher, err := newNamespaceSelectionMatcher(policy.Spec.SourceNamespaces)
+		if err != nil {
+			// Invalid policies are ignored to avoid granting more than what was intended
+			log.FromContext(ctx).Error(err, "Ignoring invalid cluster replication policy", "policyName", policy.GetName())
+			continue
+		}
+		if matcher.Matches(ns) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
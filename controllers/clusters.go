@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+const (
+	// remoteClusterTimeout limits the time taken by requests to remote clusters
+	remoteClusterTimeout = 30 * time.Second
+	// remoteClusterSyncInterval is the interval in which sources replicated into remote clusters are
+	// re-synced, as the namespaces of remote clusters are not watched
+	remoteClusterSyncInterval = 5 * time.Minute
+)
+
+// remoteCluster is a cluster, other than the cluster the operator runs in, into which sources are replicated
+type remoteCluster struct {
+	name   string
+	client client.Client
+}
+
+// RemoteClusterRegistry holds the clients of the remote clusters defined by kubeconfig Secrets in a
+// namespace. The clients are created when first used and re-created when the Secrets are updated.
+// The registry is shared between the controllers, and hence is safe for concurrent use.
+type RemoteClusterRegistry struct {
+	namespace string
+	lock      sync.Mutex
+	clusters  map[string]cachedRemoteCluster
+}
+
+type cachedRemoteCluster struct {
+	uid             types.UID
+	resourceVersion string
+	cluster         *remoteCluster
+}
+
+// NewRemoteClusterRegistry creates a registry of the remote clusters defined in a namespace
+func NewRemoteClusterRegistry(namespace string) *RemoteClusterRegistry {
+	return &RemoteClusterRegistry{
+		namespace: namespace,
+		clusters:  map[string]cachedRemoteCluster{},
+	}
+}
+
+// isClusterSecret returns true if an object is a kubeconfig Secret defining a remote cluster
+func (r *RemoteClusterRegistry) isClusterSecret(object client.Object) bool {
+	return object.GetNamespace() == r.namespace &&
+		object.GetLabels()[secretTypeLabelKey] == secretTypeLabelValueKubeconfig
+}
+
+// list returns the remote clusters which are not being removed. The clusters with invalid kubeconfig
+// Secrets are skipped, as they are reported by the remote cluster controller. A nil registry does not
+// hold any clusters.
+func (r *RemoteClusterRegistry) list(ctx context.Context, reader client.Reader,
+	scheme *runtime.Scheme) ([]*remoteCluster, error) {
+	if r == nil {
+		return nil, nil
+	}
+	secretList := &corev1.SecretList{}
+	err := reader.List(ctx, secretList, client.InNamespace(r.namespace),
+		client.MatchingLabels{secretTypeLabelKey: secretTypeLabelValueKubeconfig})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote cluster secrets: %+w", err)
+	}
+
+	clusters := []*remoteCluster{}
+	for _, secret := range secretList.Items {
+		if secret.GetDeletionTimestamp() != nil {
+			continue
+		}
+		cluster, err := r.get(&secret, scheme)
+		if err != nil {
+			log.FromContext(ctx).V(1).Info("Ignoring remote cluster with invalid kubeconfig",
+				"cluster", secret.GetName(), "reason", err.Error())
+			continue
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters, nil
+}
+
+// get returns the remote cluster defined by a kubeconfig Secret, creating its client if the Secret
+// was changed since the client was last created
+func (r *RemoteClusterRegistry) get(secret *corev1.Secret, scheme *runtime.Scheme) (*remoteCluster, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	cached, ok := r.clusters[secret.GetName()]
+	if ok && cached.uid == secret.GetUID() && cached.resourceVersion == secret.GetResourceVersion() {
+		return cached.cluster, nil
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(secret.Data[kubeconfigSecretKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig of remote cluster %s: %+w", secret.GetName(), err)
+	}
+	restConfig.Timeout = remoteClusterTimeout
+	clusterClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for remote cluster %s: %+w", secret.GetName(), err)
+	}
+
+	cluster := &remoteCluster{
+		name:   secret.GetName(),
+		client: NewTracingClient(clusterClient),
+	}
+	r.clusters[secret.GetName()] = cachedRemoteCluster{
+		uid:             secret.GetUID(),
+		resourceVersion: secret.GetResourceVersion(),
+		cluster:         cluster,
+	}
+	return cluster, nil
+}
+
+// forget removes the cached client of a remote cluster
+func (r *RemoteClusterRegistry) forget(name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.clusters, name)
+}
+
+// getSourceTargetClusters reads the names of the remote clusters targeted by a source object
+func getSourceTargetClusters(sourceObject client.Object) map[string]bool {
+	clusters := map[string]bool{}
+	for _, name := range strings.Split(sourceObject.GetAnnotations()[targetClustersAnnotationKey], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			clusters[name] = true
+		}
+	}
+	return clusters
+}
+
+// getTarget returns the client and the object type used for replicas in a cluster. A nil cluster
+// refers to the cluster the operator runs in. Replicas in remote clusters use a separate object type
+// so that they are ignored by any replicator running in the remote cluster itself.
+func (c *remoteCluster) getTarget(k8sClient client.Client) (client.Client, string) {
+	if c == nil {
+		return k8sClient, objectTypeLabelValueReplica
+	}
+	return c.client, objectTypeLabelValueRemoteReplica
+}
+
+// describeNamespace describes a namespace of a cluster for events. A nil cluster refers to the
+// cluster the operator runs in.
+func (c *remoteCluster) describeNamespace(ns string) string {
+	if c == nil {
+		return ns
+	}
+	return fmt.Sprintf("%s of cluster %s", ns, c.name)
+}
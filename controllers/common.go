@@ -16,9 +16,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
 	"github.com/nadundesilva/k8s-replicator/controllers/replication"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -55,13 +57,118 @@ func newManagerOptions(mgr ctrl.Manager, name string, kind string) ctrlControlle
 	}
 }
 
+// replicaOptions holds the configuration applied when creating or updating a replica
+type replicaOptions struct {
+	// policyName is the name of the ReplicationPolicy managing the replica (if any)
+	policyName string
+	// conflictPolicy defines how an existing object which is not a replica of the source is handled
+	conflictPolicy v1alpha1.ConflictPolicy
+	// keyFilter limits the replicated data keys in addition to the filter requested by the source object
+	keyFilter replication.KeyFilter
+	// transforms are applied to the replica after the transforms requested by the source object
+	transforms []replication.TransformSpec
+	// cluster is the remote cluster the replica is created in (nil for the cluster the operator runs in)
+	cluster *remoteCluster
+	// apiReader reads the objects missing in the cache, such as the objects which are not marked
+	apiReader client.Reader
+}
+
+// validateSourceReplicaOptions validates the replica options requested by a source object using
+// annotations. The event reason for reporting the problem is returned along with the error.
+func validateSourceReplicaOptions(sourceObject client.Object, ns string) (string, error) {
+	if _, err := getReplicaName(sourceObject, ns); err != nil {
+		return InvalidTargetName, fmt.Errorf("invalid target name: %+w", err)
+	}
+	if _, err := newSourceKeyFilter(sourceObject); err != nil {
+		return InvalidKeyFilter, fmt.Errorf("invalid key filter: %+w", err)
+	}
+	if _, err := getSourceTransforms(sourceObject); err != nil {
+		return InvalidTransforms, fmt.Errorf("invalid transforms: %+w", err)
+	}
+	return "", nil
+}
+
+// errReplicaRecreationRequired is returned while updating a replica which cannot be updated into the
+// desired state, such as a Secret replica of which the type needs to be changed
+var errReplicaRecreationRequired = fmt.Errorf("replica needs to be recreated")
+
 func replicateObject(ctx context.Context, k8sClient client.Client, eventRecorder record.EventRecorder,
-	ns string, sourceObject client.Object, replicator replication.Replicator) error {
+	ns string, sourceObject client.Object, replicator replication.Replicator, options replicaOptions) error {
+	replicaName, err := getReplicaName(sourceObject, ns)
+	if err != nil {
+		return err
+	}
+	keyFilter, err := newSourceKeyFilter(sourceObject)
+	if err != nil {
+		return err
+	}
+	keyFilter = keyFilter.And(options.keyFilter)
+	transforms, err := getSourceTransforms(sourceObject)
+	if err != nil {
+		return err
+	}
+	transformPipeline, err := replication.NewTransformPipeline(sourceObject, append(transforms, options.transforms...))
+	if err != nil {
+		return err
+	}
+	targetClient, replicaType := options.cluster.getTarget(k8sClient)
+	transformCtx := replication.TransformContext{
+		Reader:       k8sClient,
+		TargetReader: targetClient,
+		SourceObject: sourceObject,
+	}
+	if cacheMarkedObjectsOnly && options.apiReader != nil {
+		// The objects referenced by the transforms are not marked, and hence not cached
+		transformCtx.Reader = options.apiReader
+		if options.cluster == nil {
+			transformCtx.TargetReader = options.apiReader
+		}
+	}
+	if len(transformPipeline) > 0 {
+		transformCtx.TargetNamespace = &corev1.Namespace{}
+		if err := targetClient.Get(ctx, client.ObjectKey{Name: ns}, transformCtx.TargetNamespace); err != nil {
+			return fmt.Errorf("failed to get target namespace %s: %+w", ns, err)
+		}
+		// Transforms modify the replicated data, which may be shared with the source object
+		sourceObject = sourceObject.DeepCopyObject().(client.Object)
+	}
+
 	clonedObject := replicator.EmptyObject()
 	clonedObject.SetNamespace(ns)
-	clonedObject.SetName(sourceObject.GetName())
+	clonedObject.SetName(replicaName)
+
+	isAdopted := false
+	var supersededSource client.Object
+	var result controllerutil.OperationResult
+	mutate := func() error {
+		var existingObject client.Object
+		if clonedObject.GetResourceVersion() != "" {
+			existingObject = clonedObject.DeepCopyObject().(client.Object)
+		}
+		if clonedObject.GetResourceVersion() != "" && !isReplicaOfType(clonedObject, sourceObject, replicaType) {
+			if hasObjectType(clonedObject, replicaType) {
+				// Replicas of other sources (including the sources of other policies) are taken over based
+				// on the precedence of the sources
+				competingSource, err := resolveCompetingSource(ctx, k8sClient, options.apiReader, replicator,
+					clonedObject, sourceObject)
+				if err != nil {
+					return err
+				}
+				supersededSource = competingSource
+			} else {
+				// Policies are only known in the cluster the operator runs in
+				var policyReader client.Reader
+				if options.cluster == nil {
+					policyReader = k8sClient
+				}
+				err := checkAdoption(ctx, policyReader, replicator.GetKind(), clonedObject, options.conflictPolicy)
+				if err != nil {
+					return err
+				}
+				isAdopted = true
+			}
+		}
 
-	result, err := ctrl.CreateOrUpdate(ctx, k8sClient, clonedObject, func() error {
 		copyMap := func(sourceMap map[string]string, targetMap map[string]string) {
 			if sourceMap == nil {
 				return
@@ -73,13 +180,26 @@ func replicateObject(ctx context.Context, k8sClient client.Client, eventRecorder
 			}
 		}
 		replicator.Replicate(sourceObject, clonedObject)
+		if err := transformPipeline.Apply(ctx, transformCtx, clonedObject); err != nil {
+			return err
+		}
+		if filteringReplicator, ok := replicator.(replication.KeyFilteringReplicator); ok && keyFilter != nil {
+			// The keys are filtered after the transforms, so that the keys added by them are filtered as well
+			filteringReplicator.ReplicateFiltered(clonedObject.DeepCopyObject().(client.Object), clonedObject, keyFilter)
+		}
 
 		labels := clonedObject.GetLabels()
 		if labels == nil {
 			labels = map[string]string{}
 		}
 		copyMap(sourceObject.GetLabels(), labels)
-		labels[objectTypeLabelKey] = objectTypeLabelValueReplica
+		labels[objectTypeLabelKey] = replicaType
+		if options.cluster != nil {
+			labels[sourceUIDLabelKey] = string(sourceObject.GetUID())
+			// Replicas in remote clusters are not watched, and hence a finalizer would never be removed when
+			// they are deleted by hand or when the remote cluster stops being managed by the replicator
+			controllerutil.RemoveFinalizer(clonedObject, resourceFinalizer)
+		}
 		clonedObject.SetLabels(labels)
 
 		annotations := clonedObject.GetAnnotations()
@@ -88,33 +208,204 @@ func replicateObject(ctx context.Context, k8sClient client.Client, eventRecorder
 		}
 		copyMap(sourceObject.GetAnnotations(), annotations)
 		annotations[sourceNamespaceAnnotationKey] = sourceObject.GetNamespace()
+		annotations[sourceNameAnnotationKey] = sourceObject.GetName()
+		if options.policyName != "" {
+			annotations[replicationPolicyAnnotationKey] = options.policyName
+		} else {
+			delete(annotations, replicationPolicyAnnotationKey)
+		}
 		clonedObject.SetAnnotations(annotations)
+
+		recreatingReplicator, ok := replicator.(replication.RecreatingReplicator)
+		if ok && existingObject != nil && recreatingReplicator.RequiresRecreation(existingObject, clonedObject) {
+			return errReplicaRecreationRequired
+		}
 		return nil
-	})
+	}
+	result, err = ctrl.CreateOrUpdate(ctx, targetClient, clonedObject, mutate)
+	if errors.IsAlreadyExists(err) && options.cluster == nil && options.apiReader != nil {
+		// Objects missing in the cache (e.g. objects which are not marked) are read using the API server
+		result, err = ctrl.CreateOrUpdate(ctx, &liveReadClient{Client: targetClient, apiReader: options.apiReader},
+			clonedObject, mutate)
+	}
+	if err == errReplicaRecreationRequired {
+		log.FromContext(ctx).V(1).Info("Recreating replica which cannot be updated", "namespace", ns,
+			"objectName", replicaName)
+		err = removeFinalizer(ctx, targetClient, clonedObject)
+		if err == nil {
+			err = client.IgnoreNotFound(targetClient.Delete(ctx, clonedObject))
+		}
+		if err == nil {
+			clonedObject = replicator.EmptyObject()
+			clonedObject.SetNamespace(ns)
+			clonedObject.SetName(replicaName)
+			if err = mutate(); err == nil {
+				err = targetClient.Create(ctx, clonedObject)
+				result = controllerutil.OperationResultUpdated
+			}
+		}
+	}
+	target := options.cluster.describeNamespace(ns)
 	if err != nil {
-		return fmt.Errorf("failed to replicate resource to namespace %v: %+w", ns, err)
+		if isReplicaConflictError(err) {
+			recordReplicationError(replicator.GetKind(), ReplicaConflict)
+			eventRecorder.Eventf(sourceObject, "Warning", ReplicaConflict, "replica in namespace %s not created: %v", target, err)
+			log.FromContext(ctx).V(1).Info("Ignoring namespace with conflicting object", "namespace", ns,
+				"objectName", replicaName, "reason", err.Error())
+		} else {
+			recordReplicationError(replicator.GetKind(), errorReasonReplicationFailed)
+		}
+		return fmt.Errorf("failed to replicate resource to namespace %v: %+w", target, err)
+	}
+	if supersededSource != nil {
+		eventRecorder.Eventf(supersededSource, "Warning", ReplicaConflict,
+			"replica in namespace %s taken over by the source in namespace %s which takes precedence",
+			target, sourceObject.GetNamespace())
+		log.FromContext(ctx).V(1).Info("Took over replica from source with lower precedence", "namespace", ns,
+			"objectName", replicaName, "supersededSourceNamespace", supersededSource.GetNamespace())
+	}
+	if isAdopted {
+		eventRecorder.Eventf(sourceObject, "Normal", ReplicaAdopted, "existing object in namespace %s adopted as replica", target)
+		log.FromContext(ctx).V(1).Info("Adopted existing object as replica", "namespace", ns, "objectName", replicaName)
 	}
 	switch result {
 	case controllerutil.OperationResultCreated:
-		eventRecorder.Eventf(sourceObject, "Normal", SourceObjectCreate, "replica in namespace %s created", ns)
-		log.FromContext(ctx).V(1).Info("Created replica", "namespace", ns, "objectName", sourceObject.GetName())
+		eventRecorder.Eventf(sourceObject, "Normal", SourceObjectCreate, "replica in namespace %s created", target)
+		log.FromContext(ctx).V(1).Info("Created replica", "namespace", ns, "objectName", replicaName)
+		recordReplicaOperation(replicator.GetKind(), replicaOperationCreated)
+
+		// Replicas are created in namespaces created after the source was changed as well
+		changeTime := getSourceChangeTime(sourceObject)
+		targetNamespace := &corev1.Namespace{}
+		if err := targetClient.Get(ctx, client.ObjectKey{Name: ns}, targetNamespace); err == nil &&
+			targetNamespace.GetCreationTimestamp().After(changeTime) {
+			changeTime = targetNamespace.GetCreationTimestamp().Time
+		}
+		recordReplicationLatency(replicator.GetKind(), changeTime)
 	case controllerutil.OperationResultUpdated:
-		eventRecorder.Eventf(sourceObject, "Normal", SourceObjectUpdate, "replica in namespace %s updated", ns)
-		log.FromContext(ctx).V(1).Info("Updated replica", "namespace", ns, "objectName", sourceObject.GetName())
+		eventRecorder.Eventf(sourceObject, "Normal", SourceObjectUpdate, "replica in namespace %s updated", target)
+		log.FromContext(ctx).V(1).Info("Updated replica", "namespace", ns, "objectName", replicaName)
+		recordReplicaOperation(replicator.GetKind(), replicaOperationUpdated)
+		recordReplicationLatency(replicator.GetKind(), getSourceChangeTime(sourceObject))
 	case controllerutil.OperationResultNone:
-		log.FromContext(ctx).V(2).Info("No changes needed for replica", "namespace", ns, "objectName", sourceObject.GetName())
+		log.FromContext(ctx).V(2).Info("No changes needed for replica", "namespace", ns, "objectName", replicaName)
+	}
+
+	if options.cluster == nil {
+		err = addFinalizer(ctx, targetClient, clonedObject)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	err = addFinalizer(ctx, k8sClient, clonedObject)
+func deleteReplica(ctx context.Context, k8sClient client.Client, eventRecorder record.EventRecorder,
+	ns string, sourceObject client.Object, replicator replication.Replicator, reason string) error {
+	replicaName, err := getReplicaName(sourceObject, ns)
 	if err != nil {
 		return err
 	}
+	replica := replicator.EmptyObject()
+	err = k8sClient.Get(ctx, client.ObjectKey{Namespace: ns, Name: replicaName}, replica)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		} else {
+			return fmt.Errorf("failed to get replica in namespace %s: %+w", ns, err)
+		}
+	}
+	if !isReplicaOf(replica, sourceObject) {
+		log.FromContext(ctx).V(2).Info("Ignoring object not replicated from source", "replicaNamespace", ns)
+		return nil
+	}
+
+	log.FromContext(ctx).V(1).Info("Deleting replica", "replicaNamespace", ns, "reason", reason)
+	err = deleteObject(ctx, k8sClient, replicator.GetKind(), replica)
+	if err != nil {
+		return err
+	}
+	eventRecorder.Eventf(sourceObject, "Normal", SourceObjectDelete, "replica in namespace %s deleted", ns)
 	return nil
 }
 
-func deleteObject(ctx context.Context, k8sClient client.Client, object client.Object) error {
+// deleteStaleReplicas deletes the replicas of a source object in a cluster (nil for the cluster the
+// operator runs in) for which isDesired returns false
+func deleteStaleReplicas(ctx context.Context, k8sClient client.Client, eventRecorder record.EventRecorder,
+	cluster *remoteCluster, sourceObject client.Object, replicator replication.Replicator, reason string,
+	isDesired func(replica client.Object) bool) error {
+	targetClient, replicaType := cluster.getTarget(k8sClient)
+	listOptions := []client.ListOption{}
+	if cluster == nil {
+		// The replicas in the cluster the operator runs in are looked up using the cache index
+		listOptions = append(listOptions, client.MatchingLabels{objectTypeLabelKey: replicaType},
+			client.MatchingFields{
+				replicaSourceIndexField: replicaSourceIndexValue(sourceObject.GetNamespace(), sourceObject.GetName()),
+			})
+	} else {
+		// The remote clusters are not cached, and hence the replicas are looked up using the source UID label
+		listOptions = append(listOptions, client.MatchingLabels{
+			objectTypeLabelKey: replicaType,
+			sourceUIDLabelKey:  string(sourceObject.GetUID()),
+		})
+	}
+	replicaList := replicator.EmptyObjectList()
+	err := targetClient.List(ctx, replicaList, listOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to list replicas: %+w", err)
+	}
+
+	errs := []error{}
+	for _, replica := range replicator.ObjectListToArray(replicaList) {
+		if !isReplicaOfType(replica, sourceObject, replicaType) || isDesired(replica) {
+			continue
+		}
+
+		log.FromContext(ctx).V(1).Info("Deleting replica", "replicaNamespace", replica.GetNamespace(),
+			"replicaName", replica.GetName(), "reason", reason)
+		err := deleteObject(ctx, targetClient, replicator.GetKind(), replica)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		eventRecorder.Eventf(sourceObject, "Normal", SourceObjectDelete, "replica in namespace %s deleted",
+			cluster.describeNamespace(replica.GetNamespace()))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to delete stale replicas: %+v", errs)
+	}
+	return nil
+}
+
+func isReplica(object client.Object) bool {
+	return hasObjectType(object, objectTypeLabelValueReplica)
+}
+
+func hasObjectType(object client.Object, objectType string) bool {
+	actualObjectType, objectTypeOk := object.GetLabels()[objectTypeLabelKey]
+	return objectTypeOk && actualObjectType == objectType
+}
+
+func isReplicaOf(object client.Object, sourceObject client.Object) bool {
+	return isReplicaOfType(object, sourceObject, objectTypeLabelValueReplica)
+}
+
+// isReplicaOfType checks whether an object is a replica of a source object with the object type used
+// for replicas in the cluster the object is in
+func isReplicaOfType(object client.Object, sourceObject client.Object, replicaType string) bool {
+	if !hasObjectType(object, replicaType) {
+		return false
+	}
+	sourceNamespace, sourceNamespaceOk := object.GetAnnotations()[sourceNamespaceAnnotationKey]
+	return sourceNamespaceOk && sourceNamespace == sourceObject.GetNamespace() &&
+		getReplicaSourceName(object) == sourceObject.GetName()
+}
+
+// deleteObject deletes an object of a replicated kind, recording the deletion in the metrics of the kind
+func deleteObject(ctx context.Context, k8sClient client.Client, kind string, object client.Object) error {
 	err := removeFinalizer(ctx, k8sClient, object)
 	if err != nil {
+		recordReplicationError(kind, errorReasonReplicaDeleteFailed)
 		return fmt.Errorf("failed to remove finalizer before deletion: %w", err)
 	}
 
@@ -127,9 +418,11 @@ func deleteObject(ctx context.Context, k8sClient client.Client, object client.Ob
 			// Object already deleted, this is not an error
 			return nil
 		} else {
+			recordReplicationError(kind, errorReasonReplicaDeleteFailed)
 			return fmt.Errorf("failed to delete object %s/%s: %w", object.GetNamespace(), object.GetName(), err)
 		}
 	}
+	recordReplicaOperation(kind, replicaOperationDeleted)
 	return nil
 }
 
@@ -193,6 +486,75 @@ func retryOperation(ctx context.Context, operation func() error) error {
 	return fmt.Errorf("operation failed after %d retries", maxRetries)
 }
 
+// iterateNamespaces runs the handler for each namespace which can be replicated into. The handler is
+// run for up to namespaceParallelism namespaces at once (and hence needs to be safe for concurrent use),
+// and is given a context holding the span of the namespace.
+func iterateNamespaces(ctx context.Context, k8sClient client.Client,
+	handler func(ctx context.Context, ns corev1.Namespace) error) error {
+	namespaceList := &corev1.NamespaceList{}
+	err := k8sClient.List(ctx, namespaceList, &client.ListOptions{
+		LabelSelector: namespaceSelector,
+	})
+	if err != nil {
+		return err
+	}
+
+	namespaces := []corev1.Namespace{}
+	for _, ns := range namespaceList.Items {
+		if isNamespaceIgnored(&ns) || ns.GetDeletionTimestamp() != nil {
+			continue
+		}
+		namespaces = append(namespaces, ns)
+	}
+
+	// The errors are collected by the index of the namespace to report them in the order of the namespaces
+	nsErrs := make([]error, len(namespaces))
+	workers := make(chan struct{}, namespaceParallelism)
+	wg := sync.WaitGroup{}
+	for i, ns := range namespaces {
+		workers <- struct{}{}
+		wg.Add(1)
+		go func() {
+			nsCtx, span := startSpan(ctx, "Namespace", namespaceAttributeKey.String(ns.GetName()))
+			defer func() {
+				if r := recover(); r != nil {
+					nsErrs[i] = fmt.Errorf("panic while running reconciliation for namespace %s: %v", ns.GetName(), r)
+				}
+				endSpan(span, nsErrs[i])
+				<-workers
+				wg.Done()
+			}()
+
+			err := handler(nsCtx, ns)
+			if err != nil {
+				nsErrs[i] = fmt.Errorf("failed to run reconciliation for namespace %s: %+w", ns.GetName(), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	errs := []error{}
+	for _, err := range nsErrs {
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to iterate namespaces: %+v", errs)
+	}
+	return nil
+}
+
+// replicaSourceReader returns the reader of the source object of a replica. The source objects selected by
+// policies are not marked, and hence are not cached when caching only the marked objects.
+func replicaSourceReader(k8sClient client.Client, apiReader client.Reader, replica client.Object) client.Reader {
+	_, isPolicyManaged := replica.GetAnnotations()[replicationPolicyAnnotationKey]
+	if isPolicyManaged && cacheMarkedObjectsOnly && apiReader != nil {
+		return apiReader
+	}
+	return k8sClient
+}
+
 type sourceStatus string
 
 const (
@@ -202,17 +564,38 @@ const (
 	sourceStatusAvailable sourceStatus = "Available"
 )
 
-func getReplicaSourceStatus(ctx context.Context, k8sClient client.Client, replica client.Object,
-	replicator replication.Replicator) (sourceStatus, error) {
+func getReplicaSourceStatus(ctx context.Context, k8sClient client.Client, apiReader client.Reader,
+	replica client.Object, replicator replication.Replicator) (sourceStatus, error) {
 	sourceNamespace, sourceNamespaceOk := replica.GetAnnotations()[sourceNamespaceAnnotationKey]
 	if !sourceNamespaceOk {
 		return "", fmt.Errorf("replica %s/%s does not contain %s annotation",
 			replica.GetNamespace(), replica.GetName(), sourceNamespaceAnnotationKey)
 	}
 
+	policyName, isPolicyManaged := replica.GetAnnotations()[replicationPolicyAnnotationKey]
+	if isPolicyManaged {
+		policy := &v1alpha1.ReplicationPolicy{}
+		policyKey := client.ObjectKey{Namespace: sourceNamespace, Name: policyName}
+		if err := k8sClient.Get(ctx, policyKey, policy); err != nil {
+			if errors.IsNotFound(err) {
+				return sourceStatusNotFound, nil
+			} else {
+				return "", fmt.Errorf("failed to get replication policy: %+w", err)
+			}
+		}
+		if policy.GetDeletionTimestamp() != nil {
+			if policy.Spec.Options.DeletionPolicy == v1alpha1.DeletionPolicyRetain {
+				// Retained replicas are released by the policy controller
+				return sourceStatusAvailable, nil
+			}
+			return sourceStatusDeleted, nil
+		}
+	}
+
 	sourceObject := replicator.EmptyObject()
-	sourceObjectKey := client.ObjectKey{Namespace: sourceNamespace, Name: replica.GetName()}
-	if err := k8sClient.Get(ctx, sourceObjectKey, sourceObject); err != nil {
+	sourceObjectKey := client.ObjectKey{Namespace: sourceNamespace, Name: getReplicaSourceName(replica)}
+	err := replicaSourceReader(k8sClient, apiReader, replica).Get(ctx, sourceObjectKey, sourceObject)
+	if err != nil {
 		if errors.IsNotFound(err) {
 			return sourceStatusNotFound, nil
 		} else {
@@ -223,10 +606,14 @@ func getReplicaSourceStatus(ctx context.Context, k8sClient client.Client, replic
 	if sourceObject.GetDeletionTimestamp() != nil {
 		return sourceStatusDeleted, nil
 	}
+	if isPolicyManaged {
+		// Selection of the source by the policy is maintained by the policy controller
+		return sourceStatusAvailable, nil
+	}
 
 	sourceObjectType, sourceObjectTypeOk := sourceObject.GetLabels()[objectTypeLabelKey]
 	if sourceObjectTypeOk {
-		if sourceObjectType != objectTypeLabelValueReplicated {
+		if sourceObjectType != objectTypeLabelValueReplicated && sourceObjectType != objectTypeLabelValueRequestable {
 			return "", fmt.Errorf("unexpected object type %s in source %s/%s",
 				sourceObjectType, sourceObject.GetNamespace(), sourceObject.GetName())
 		}
@@ -246,5 +633,10 @@ func isNamespaceIgnored(ns *corev1.Namespace) bool {
 			return false
 		}
 	}
-	return strings.HasPrefix(ns.GetName(), "kube-") || (operatorNamespace != "" && ns.GetName() == operatorNamespace)
+	if namespaceOptIn {
+		// Only the namespaces labeled as managed are replicated into
+		return true
+	}
+	return matchesAnyPattern(excludedNamespacePatterns, ns.GetName()) ||
+		(operatorNamespace != "" && ns.GetName() == operatorNamespace)
 }
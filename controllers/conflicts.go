@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// replicaConflictError is returned when an object not managed by the replicator occupies the name
+// of the replica in the target namespace
+type replicaConflictError struct {
+	namespace string
+	name      string
+	// competingSourceNamespace is the namespace of the source with precedence, if the object is a replica
+	competingSourceNamespace string
+	// isSource is true if the object is a source object itself, which is never replaced by a replica
+	isSource bool
+}
+
+func (e *replicaConflictError) Error() string {
+	if e.isSource {
+		return fmt.Sprintf("object %s/%s is a source object which cannot be replaced by a replica", e.namespace, e.name)
+	}
+	if e.competingSourceNamespace != "" {
+		return fmt.Sprintf("object %s/%s is a replica of the source in namespace %s which takes precedence",
+			e.namespace, e.name, e.competingSourceNamespace)
+	}
+	return fmt.Sprintf("object %s/%s already exists and is not a replica of the source object", e.namespace, e.name)
+}
+
+func isReplicaConflictError(err error) bool {
+	var conflictErr *replicaConflictError
+	return errors.As(err, &conflictErr)
+}
+
+// ignoreReplicaConflict returns nil on replica conflicts. Conflicts are reported using events and
+// replication statuses instead, as retrying does not resolve them.
+func ignoreReplicaConflict(err error) error {
+	if isReplicaConflictError(err) {
+		return nil
+	}
+	return err
+}
+
+// checkAdoption checks whether an existing object which is not a replica can be adopted as a replica,
+// and returns a replicaConflictError otherwise. Sources are never adopted, including the objects
+// selected by the policies read using the policy reader.
+func checkAdoption(ctx context.Context, policyReader client.Reader, kind string, object client.Object,
+	conflictPolicy v1alpha1.ConflictPolicy) error {
+	if conflictPolicy != v1alpha1.ConflictPolicyAdopt {
+		return &replicaConflictError{namespace: object.GetNamespace(), name: object.GetName()}
+	}
+	isSource, err := isSourceObject(ctx, policyReader, kind, object)
+	if err != nil {
+		return err
+	}
+	if isSource {
+		return &replicaConflictError{namespace: object.GetNamespace(), name: object.GetName(), isSource: true}
+	}
+	if !isAdoptable(object) {
+		return &replicaConflictError{namespace: object.GetNamespace(), name: object.GetName()}
+	}
+	return nil
+}
+
+// isSourceObject checks whether an object of a kind is a source object, which is either marked for
+// replication or selected by a replication policy in its namespace (if a policy reader is provided)
+func isSourceObject(ctx context.Context, policyReader client.Reader, kind string, object client.Object) (bool, error) {
+	switch object.GetLabels()[objectTypeLabelKey] {
+	case objectTypeLabelValueReplicated, objectTypeLabelValueRequestable:
+		return true, nil
+	}
+	if policyReader == nil {
+		return false, nil
+	}
+	policyList := &v1alpha1.ReplicationPolicyList{}
+	if err := policyReader.List(ctx, policyList, client.InNamespace(object.GetNamespace())); err != nil {
+		return false, fmt.Errorf("failed to list replication policies: %+w", err)
+	}
+	for i := range policyList.Items {
+		if isSelectedByPolicy(&policyList.Items[i], kind, object) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// isAdoptable checks whether an existing object can be adopted as a replica. Objects managed by the
+// replicator in any way (such as sources, or replicas from other clusters) are never adopted.
+func isAdoptable(object client.Object) bool {
+	_, hasObjectType := object.GetLabels()[objectTypeLabelKey]
+	return !hasObjectType && !controllerutil.ContainsFinalizer(object, resourceFinalizer)
+}
+
+// getSourceConflictPolicy reads the conflict policy requested by a source object
+func getSourceConflictPolicy(sourceObject client.Object) v1alpha1.ConflictPolicy {
+	if sourceObject.GetAnnotations()[conflictPolicyAnnotationKey] == string(v1alpha1.ConflictPolicyAdopt) {
+		return v1alpha1.ConflictPolicyAdopt
+	}
+	return v1alpha1.ConflictPolicyReject
+}
+
+// resolveCompetingSource decides whether a source object can take over a replica of a different source
+// with the same name. A replicaConflictError is returned if the competing source takes precedence, and
+// otherwise the competing source is returned (nil if it is no longer available).
+func resolveCompetingSource(ctx context.Context, k8sClient client.Client, apiReader client.Reader,
+	replicator replication.Replicator, replica client.Object, sourceObject client.Object) (client.Object, error) {
+	competingSourceStatus, err := getReplicaSourceStatus(ctx, k8sClient, apiReader, replica, replicator)
+	if err != nil {
+		return nil, err
+	}
+	if competingSourceStatus != sourceStatusAvailable {
+		return nil, nil
+	}
+
+	competingSource := replicator.EmptyObject()
+	competingSourceKey := client.ObjectKey{
+		Namespace: replica.GetAnnotations()[sourceNamespaceAnnotationKey],
+		Name:      getReplicaSourceName(replica),
+	}
+	err = replicaSourceReader(k8sClient, apiReader, replica).Get(ctx, competingSourceKey, competingSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get competing source object: %+w", err)
+	}
+	if !hasPrecedence(sourceObject, competingSource) {
+		return nil, &replicaConflictError{
+			namespace:                replica.GetNamespace(),
+			name:                     replica.GetName(),
+			competingSourceNamespace: competingSource.GetNamespace(),
+		}
+	}
+	return competingSource, nil
+}
+
+// hasPrecedence returns true if a source object takes precedence over another source with the same
+// name. The source with the highest priority wins, followed by the oldest source, and finally the
+// source with the lexicographically smallest namespace.
+func hasPrecedence(sourceObject client.Object, otherSource client.Object) bool {
+	priority, otherPriority := getSourcePriority(sourceObject), getSourcePriority(otherSource)
+	if priority != otherPriority {
+		return priority > otherPriority
+	}
+	creationTime, otherCreationTime := sourceObject.GetCreationTimestamp(), otherSource.GetCreationTimestamp()
+	if !creationTime.Equal(&otherCreationTime) {
+		return creationTime.Before(&otherCreationTime)
+	}
+	return sourceObject.GetNamespace() < otherSource.GetNamespace()
+}
+
+// getSourcePriority reads the priority of a source object. Sources without a valid priority have
+// the priority 0.
+func getSourcePriority(sourceObject client.Object) int64 {
+	priority, err := strconv.ParseInt(sourceObject.GetAnnotations()[priorityAnnotationKey], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return priority
+}
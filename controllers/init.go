@@ -16,6 +16,8 @@ package controllers
 import (
 	"fmt"
 	"os"
+	"path"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -28,36 +30,82 @@ const (
 	namespaceTypeLabelValueManaged = "managed"
 	namespaceTypeLabelValueIgnored = "ignored"
 
-	objectTypeLabelKey             = groupFqn + "/object-type"
-	objectTypeLabelValueReplicated = "replicated"
-	objectTypeLabelValueReplica    = "replica"
+	objectTypeLabelKey                = groupFqn + "/object-type"
+	objectTypeLabelValueReplicated    = "replicated"
+	objectTypeLabelValueRequestable   = "requestable"
+	objectTypeLabelValueReplica       = "replica"
+	objectTypeLabelValueRemoteReplica = "remote-replica"
+
+	// sourceUIDLabelKey is set on the replicas in remote clusters for looking them up without listing
+	// all the replicas of the remote clusters
+	sourceUIDLabelKey = groupFqn + "/source-uid"
+
+	secretTypeLabelKey             = groupFqn + "/secret-type"
+	secretTypeLabelValueKubeconfig = "kubeconfig"
+	kubeconfigSecretKey            = "kubeconfig"
 
 	resourceFinalizer = groupFqn + "/finalizer"
+	clusterFinalizer  = groupFqn + "/cluster-finalizer"
 
 	sourceNamespaceAnnotationKey = groupFqn + "/source-namespace"
+	sourceNameAnnotationKey      = groupFqn + "/source-name"
+	targetNameAnnotationKey      = groupFqn + "/target-name"
+
+	includeKeysAnnotationKey = groupFqn + "/include-keys"
+	excludeKeysAnnotationKey = groupFqn + "/exclude-keys"
+	transformsAnnotationKey  = groupFqn + "/transforms"
+
+	targetNamespaceSelectorAnnotationKey = groupFqn + "/target-namespace-selector"
+	targetNamespacesAnnotationKey        = groupFqn + "/target-namespaces"
+	targetClustersAnnotationKey          = groupFqn + "/target-clusters"
+
+	replicationPolicyAnnotationKey = groupFqn + "/replication-policy"
+
+	conflictPolicyAnnotationKey = groupFqn + "/conflict-policy"
+	priorityAnnotationKey       = groupFqn + "/priority"
 
 	SourceObjectCreate = "SourceObjectCreate"
 	SourceObjectUpdate = "SourceObjectUpdate"
 	SourceObjectDelete = "SourceObjectDelete"
+
+	InvalidTargetNamespaces = "InvalidTargetNamespaces"
+	InvalidTargetName       = "InvalidTargetName"
+	InvalidKeyFilter        = "InvalidKeyFilter"
+	InvalidTransforms       = "InvalidTransforms"
+	InvalidPolicy           = "InvalidPolicy"
+	SourceNotAllowed        = "SourceNotAllowed"
+	ReplicaConflict         = "ReplicaConflict"
+	ReplicaAdopted          = "ReplicaAdopted"
+	InvalidReplicatedKind   = "InvalidReplicatedKind"
+	InvalidKubeconfig       = "InvalidKubeconfig"
 )
 
 var (
-	namespaceSelector        labels.Selector
-	replicaResourcesSelector labels.Selector
+	namespaceSelector           labels.Selector
+	replicaResourcesSelector    labels.Selector
+	replicatedResourcesSelector labels.Selector
 
 	operatorNamespace = os.Getenv("OPERATOR_NAMESPACE")
+	// namespaceOptIn limits replication to the namespaces labeled as managed
+	namespaceOptIn = false
+	// excludedNamespacePatterns are the glob patterns of the system namespaces which are not replicated
+	// into unless labeled as managed
+	excludedNamespacePatterns = []string{"kube-*"}
+	// namespaceParallelism is the maximum number of namespaces a single reconcile replicates into at once
+	namespaceParallelism = DefaultNamespaceParallelism
+	// cacheMarkedObjectsOnly limits the cached objects of the replicated kinds to the objects marked using
+	// the object type label, and hence the objects which are not marked are read using the API server
+	cacheMarkedObjectsOnly = false
+	// clusterReplicationPolicyRequired denies replicating any source while there are no cluster replication policies
+	clusterReplicationPolicyRequired = false
 )
 
+// DefaultNamespaceParallelism is the default maximum number of namespaces a single reconcile
+// replicates into at once
+const DefaultNamespaceParallelism = 10
+
 func init() {
-	namespaceSelectorReq, err := labels.NewRequirement(
-		namespaceTypeLabelKey,
-		selection.NotEquals,
-		[]string{namespaceTypeLabelValueIgnored},
-	)
-	if err != nil {
-		panic(fmt.Errorf("failed to initialize namespace selector %+w", err))
-	}
-	namespaceSelector = labels.NewSelector().Add(*namespaceSelectorReq)
+	namespaceSelector = newNamespaceSelector(namespaceOptIn)
 
 	replicaResourcesSelectorReq, err := labels.NewRequirement(
 		objectTypeLabelKey,
@@ -68,4 +116,88 @@ func init() {
 		panic(fmt.Errorf("failed to initialize replica resources selector %+w", err))
 	}
 	replicaResourcesSelector = labels.NewSelector().Add(*replicaResourcesSelectorReq)
+
+	replicatedResourcesSelectorReq, err := labels.NewRequirement(
+		objectTypeLabelKey,
+		selection.In,
+		[]string{objectTypeLabelValueReplicated, objectTypeLabelValueRequestable},
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to initialize replicated resources selector %+w", err))
+	}
+	replicatedResourcesSelector = labels.NewSelector().Add(*replicatedResourcesSelectorReq)
+}
+
+// SetNamespaceOptIn switches between replicating into all the namespaces which are not ignored (the
+// default), and replicating only into the namespaces labeled as managed. This needs to be called
+// before the controllers are started.
+func SetNamespaceOptIn(optIn bool) {
+	namespaceOptIn = optIn
+	namespaceSelector = newNamespaceSelector(optIn)
+}
+
+// SetNamespaceParallelism sets the maximum number of namespaces a single reconcile replicates into at
+// once. This needs to be called before the controllers are started.
+func SetNamespaceParallelism(parallelism int) error {
+	if parallelism < 1 {
+		return fmt.Errorf("namespace parallelism %d is not a positive number", parallelism)
+	}
+	namespaceParallelism = parallelism
+	return nil
+}
+
+// SetCacheMarkedObjectsOnly switches between caching all the objects of the replicated kinds (the default),
+// and caching only the objects marked using the object type label. The cache of the Manager needs to be
+// configured using NewCacheByObject, and this needs to be called before the Manager is created.
+func SetCacheMarkedObjectsOnly(markedOnly bool) {
+	cacheMarkedObjectsOnly = markedOnly
+}
+
+// SetClusterReplicationPolicyRequired switches between allowing all the sources while there are no
+// cluster replication policies (the default), and allowing only the sources allowed by a cluster
+// replication policy. This needs to be called before the controllers are started.
+func SetClusterReplicationPolicyRequired(required bool) {
+	clusterReplicationPolicyRequired = required
+}
+
+// SetExcludedNamespaces replaces the glob patterns of the system namespaces which are not replicated
+// into unless labeled as managed (kube-* by default). This needs to be called before the controllers
+// are started.
+func SetExcludedNamespaces(patterns []string) error {
+	validPatterns := []string{}
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid excluded namespace pattern %q: %+w", pattern, err)
+		}
+		validPatterns = append(validPatterns, pattern)
+	}
+	excludedNamespacePatterns = validPatterns
+	return nil
+}
+
+// newNamespaceSelector creates the selector for listing the namespaces which can be replicated into
+func newNamespaceSelector(optIn bool) labels.Selector {
+	var namespaceSelectorReq *labels.Requirement
+	var err error
+	if optIn {
+		namespaceSelectorReq, err = labels.NewRequirement(
+			namespaceTypeLabelKey,
+			selection.Equals,
+			[]string{namespaceTypeLabelValueManaged},
+		)
+	} else {
+		namespaceSelectorReq, err = labels.NewRequirement(
+			namespaceTypeLabelKey,
+			selection.NotEquals,
+			[]string{namespaceTypeLabelValueIgnored},
+		)
+	}
+	if err != nil {
+		panic(fmt.Errorf("failed to initialize namespace selector %+w", err))
+	}
+	return labels.NewSelector().Add(*namespaceSelectorReq)
 }
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newSourceKeyFilter creates the key filter requested by a source object using annotations. A nil
+// filter is returned if the source object does not limit the replicated keys.
+func newSourceKeyFilter(sourceObject client.Object) (replication.KeyFilter, error) {
+	includeKeys, includeKeysOk := sourceObject.GetAnnotations()[includeKeysAnnotationKey]
+	excludeKeys, excludeKeysOk := sourceObject.GetAnnotations()[excludeKeysAnnotationKey]
+	if !includeKeysOk && !excludeKeysOk {
+		return nil, nil
+	}
+
+	filter, err := replication.NewKeyFilter(splitKeyPatterns(includeKeys), splitKeyPatterns(excludeKeys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key filter annotations: %+w", err)
+	}
+	return filter, nil
+}
+
+func splitKeyPatterns(patterns string) []string {
+	keys := []string{}
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			keys = append(keys, pattern)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Key Filtering", func() {
+	var sourceNamespace *corev1.Namespace
+	nc := namespaceCreator{}
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+
+		nc.Cleanup(ctx)
+	})
+
+	It("Should remove excluded keys from existing TLS replicas", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		sourceObject := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-tls-secret",
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("test-cert"),
+				corev1.TLSPrivateKeyKey: []byte("test-key"),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		for _, ns := range targetNamespaces {
+			Eventually(func() bool {
+				replica := &corev1.Secret{}
+				err := k8sClient.Get(ctx, replicaKey(sourceObject, ns), replica)
+				return err == nil && replica.Type == corev1.SecretTypeTLS
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+
+		By("excluding the private key of the source object")
+		Eventually(func() error {
+			latestSourceObject := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject); err != nil {
+				return err
+			}
+			latestSourceObject.SetAnnotations(map[string]string{
+				excludeKeysAnnotationKey: corev1.TLSPrivateKeyKey,
+			})
+			return k8sClient.Update(ctx, latestSourceObject)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		for _, ns := range targetNamespaces {
+			Eventually(func() bool {
+				replica := &corev1.Secret{}
+				err := k8sClient.Get(ctx, replicaKey(sourceObject, ns), replica)
+				if err != nil || !isReplicaOf(replica, sourceObject) {
+					return false
+				}
+				_, hasPrivateKey := replica.Data[corev1.TLSPrivateKeyKey]
+				return !hasPrivateKey && replica.Type == corev1.SecretTypeOpaque &&
+					string(replica.Data[corev1.TLSCertKey]) == "test-cert"
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+	}, testTimeout)
+
+	It("Should filter the keys merged from other objects", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		mergedObject := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-shared-configmap",
+				Namespace: sourceNamespace.GetName(),
+			},
+			Data: map[string]string{
+				"region":        "eu",
+				"internal-host": "shared.internal",
+			},
+		}
+		Expect(k8sClient.Create(ctx, mergedObject)).To(Succeed())
+		sourceObject := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-configmap",
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+				Annotations: map[string]string{
+					excludeKeysAnnotationKey: "internal-*",
+					transformsAnnotationKey:  `[{"type": "mergeKeys", "config": {"sources": "test-shared-configmap"}}]`,
+				},
+			},
+			Data: map[string]string{
+				"team": "platform",
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		for _, ns := range targetNamespaces {
+			Eventually(func() bool {
+				replica := &corev1.ConfigMap{}
+				err := k8sClient.Get(ctx, replicaKey(sourceObject, ns), replica)
+				if err != nil {
+					return false
+				}
+				_, hasInternalHost := replica.Data["internal-host"]
+				return !hasInternalHost && replica.Data["region"] == "eu" && replica.Data["team"] == "platform"
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+	}, testTimeout)
+})
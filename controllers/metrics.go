@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "replicator"
+
+	replicaOperationCreated = "created"
+	replicaOperationUpdated = "updated"
+	replicaOperationDeleted = "deleted"
+
+	errorReasonReplicationFailed   = "ReplicationFailed"
+	errorReasonReplicaDeleteFailed = "ReplicaDeletionFailed"
+	errorReasonSourceRemovalFailed = "SourceRemovalFailed"
+
+	// metricsCollectTimeout is the maximum time spent on counting the sources and replicas for a scrape
+	metricsCollectTimeout = 10 * time.Second
+)
+
+var (
+	replicaOperationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "replica_operations_total",
+		Help:      "Number of replicas created, updated and deleted by the operator",
+	}, []string{"kind", "operation"})
+	replicationErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "replication_errors_total",
+		Help:      "Number of errors encountered while replicating sources and deleting replicas",
+	}, []string{"kind", "reason"})
+	replicationLatencySeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "replication_latency_seconds",
+		Help: "Time from a change to a source (or the creation of the target namespace) " +
+			"until the replica is synced",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"kind"})
+
+	sourcesDesc = prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "", "sources"),
+		"Number of source objects marked for replication", []string{"kind"}, nil)
+	replicasDesc = prometheus.NewDesc(prometheus.BuildFQName(metricsNamespace, "", "replicas"),
+		"Number of replicas in the cluster the operator runs in", []string{"kind"}, nil)
+)
+
+func init() {
+	metrics.Registry.MustRegister(replicaOperationsTotal, replicationErrorsTotal, replicationLatencySeconds)
+}
+
+// ReplicationCollector counts the sources and replicas of each replicated kind when metrics are scraped
+type ReplicationCollector struct {
+	reader                client.Reader
+	replicators           []replication.Replicator
+	registeredReplicators *ReplicatorRegistry
+}
+
+var _ prometheus.Collector = (*ReplicationCollector)(nil)
+
+// NewReplicationCollector creates a collector counting the objects of the static replicators and the
+// replicators registered at runtime using the reader (usually backed by the manager's cache)
+func NewReplicationCollector(reader client.Reader, replicators []replication.Replicator,
+	registeredReplicators *ReplicatorRegistry) *ReplicationCollector {
+	return &ReplicationCollector{
+		reader:                reader,
+		replicators:           replicators,
+		registeredReplicators: registeredReplicators,
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *ReplicationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sourcesDesc
+	ch <- replicasDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *ReplicationCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), metricsCollectTimeout)
+	defer cancel()
+
+	for _, replicator := range withRegisteredReplicators(c.replicators, c.registeredReplicators) {
+		for desc, selector := range map[*prometheus.Desc]labels.Selector{
+			sourcesDesc:  replicatedResourcesSelector,
+			replicasDesc: replicaResourcesSelector,
+		} {
+			objectList := replicator.EmptyObjectList()
+			err := c.reader.List(ctx, objectList, client.MatchingLabelsSelector{Selector: selector},
+				client.UnsafeDisableDeepCopy)
+			if err != nil {
+				ctrllog.FromContext(ctx).Error(err, "Failed to count objects for metrics", "objectKind",
+					replicator.GetKind())
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue,
+				float64(len(replicator.ObjectListToArray(objectList))), replicator.GetKind())
+		}
+	}
+}
+
+// recordReplicaOperation counts a replica created, updated or deleted by the operator
+func recordReplicaOperation(kind string, operation string) {
+	replicaOperationsTotal.WithLabelValues(kind, operation).Inc()
+}
+
+// recordReplicationError counts an error encountered while replicating a kind
+func recordReplicationError(kind string, reason string) {
+	replicationErrorsTotal.WithLabelValues(kind, reason).Inc()
+}
+
+// recordReplicationLatency records the time taken to sync a replica since the source or the target
+// namespace (whichever is later) was last changed
+func recordReplicationLatency(kind string, changeTime time.Time) {
+	if changeTime.IsZero() {
+		return
+	}
+	replicationLatencySeconds.WithLabelValues(kind).Observe(time.Since(changeTime).Seconds())
+}
+
+// getSourceChangeTime returns the time a source object was last changed as recorded in its managed
+// fields. Updates which only change the finalizers (such as the ones made by the operator) are not
+// considered to be changes to the source object.
+func getSourceChangeTime(object client.Object) time.Time {
+	changeTime := object.GetCreationTimestamp().Time
+	for _, managedFields := range object.GetManagedFields() {
+		if managedFields.Time == nil || managedFields.Subresource != "" ||
+			isFinalizersOnlyFieldSet(managedFields.FieldsV1.Raw) {
+			continue
+		}
+		if managedFields.Time.After(changeTime) {
+			changeTime = managedFields.Time.Time
+		}
+	}
+	return changeTime
+}
+
+// isFinalizersOnlyFieldSet checks whether a serialized field set only contains the finalizers
+func isFinalizersOnlyFieldSet(fieldSet []byte) bool {
+	if len(fieldSet) == 0 {
+		return false
+	}
+	fields := map[string]map[string]json.RawMessage{}
+	if err := json.Unmarshal(fieldSet, &fields); err != nil {
+		return false
+	}
+	metadataFields, ok := fields["f:metadata"]
+	if len(fields) != 1 || !ok {
+		return false
+	}
+	for field := range metadataFields {
+		if field != "f:finalizers" && field != "." {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Replication Metrics", func() {
+	var sourceNamespace *corev1.Namespace
+	nc := namespaceCreator{}
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+
+		nc.Cleanup(ctx)
+	})
+
+	getOperations := func(operation string) float64 {
+		return testutil.ToFloat64(replicaOperationsTotal.WithLabelValues("ConfigMap", operation))
+	}
+
+	getLatencySampleCount := func() uint64 {
+		metric := &dto.Metric{}
+		histogram := replicationLatencySeconds.WithLabelValues("ConfigMap").(prometheus.Histogram)
+		Expect(histogram.Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount()
+	}
+
+	It("Should count the replicas created, updated and deleted", func(ctx SpecContext) {
+		initialCreated := getOperations(replicaOperationCreated)
+		initialUpdated := getOperations(replicaOperationUpdated)
+		initialDeleted := getOperations(replicaOperationDeleted)
+		initialLatencySampleCount := getLatencySampleCount()
+
+		nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		sourceObject := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-config-map-" + uuid.New().String(),
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			Data: map[string]string{
+				"endpoint": "https://api.example.com",
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		Eventually(func() float64 {
+			return getOperations(replicaOperationCreated)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeNumerically(">=", initialCreated+2))
+		Eventually(getLatencySampleCount, assertionTimeout, assertionPollInterval, ctx).
+			Should(BeNumerically(">=", initialLatencySampleCount+2))
+
+		By("updating the source object")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), sourceObject)).To(Succeed())
+		sourceObject.Data["endpoint"] = "https://api.example.org"
+		Expect(k8sClient.Update(ctx, sourceObject)).To(Succeed())
+		Eventually(func() float64 {
+			return getOperations(replicaOperationUpdated)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeNumerically(">=", initialUpdated+2))
+
+		By("deleting the source object")
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+		Eventually(func() float64 {
+			return getOperations(replicaOperationDeleted)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeNumerically(">=", initialDeleted+2))
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), &corev1.ConfigMap{})
+			return err != nil && errors.IsNotFound(err)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+	}, testTimeout)
+
+	It("Should count the sources and replicas of each kind", func(ctx SpecContext) {
+		replicators := []replication.Replicator{}
+		for _, replicator := range replication.NewReplicators() {
+			if replicator.GetKind() == "ConfigMap" {
+				replicators = append(replicators, replicator)
+			}
+		}
+		registry := prometheus.NewPedanticRegistry()
+		Expect(registry.Register(NewReplicationCollector(k8sClient, replicators, nil))).To(Succeed())
+
+		nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		sourceObject := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-config-map-" + uuid.New().String(),
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+		getGauge := func(name string) float64 {
+			families, err := registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			for _, family := range families {
+				if family.GetName() != name {
+					continue
+				}
+				for _, metric := range family.GetMetric() {
+					for _, label := range metric.GetLabel() {
+						if label.GetName() == "kind" && label.GetValue() == "ConfigMap" {
+							return metric.GetGauge().GetValue()
+						}
+					}
+				}
+			}
+			return 0
+		}
+		Eventually(func() float64 {
+			return getGauge("replicator_sources")
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeNumerically(">=", 1))
+		Eventually(func() float64 {
+			return getGauge("replicator_replicas")
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeNumerically(">=", 2))
+
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+
+	DescribeTable("Identifying the changes made only to the finalizers",
+		func(fieldSet string, expected bool) {
+			Expect(isFinalizersOnlyFieldSet([]byte(fieldSet))).To(Equal(expected))
+		},
+		Entry("finalizers", `{"f:metadata":{"f:finalizers":{".":{},"v:\"example.com/finalizer\"":{}}}}`, true),
+		Entry("labels", `{"f:metadata":{"f:labels":{".":{},"f:app":{}}}}`, false),
+		Entry("finalizers and data", `{"f:data":{"f:key":{}},"f:metadata":{"f:finalizers":{}}}`, false),
+		Entry("empty", ``, false),
+	)
+})
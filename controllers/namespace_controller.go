@@ -16,6 +16,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
 	"github.com/nadundesilva/k8s-replicator/controllers/replication"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -25,17 +26,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 // NamespaceReconciler reconciles a Namespace object
 type NamespaceReconciler struct {
 	client.Client
-	Scheme   *runtime.Scheme
-	recorder record.EventRecorder
+	Scheme    *runtime.Scheme
+	recorder  record.EventRecorder
+	apiReader client.Reader
 
 	Replicators []replication.Replicator
+	// RegisteredReplicators holds the replicators of the kinds added at runtime (optional)
+	RegisteredReplicators *ReplicatorRegistry
 }
 
 //+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
@@ -71,16 +77,15 @@ func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 	isNamespaceIgnored := isNamespaceIgnored(namespace)
 
 	// Reconciling
+	replicators := withRegisteredReplicators(r.Replicators, r.RegisteredReplicators)
 	if isNamespaceDeleted || isNamespaceIgnored {
-		for _, replicator := range r.Replicators {
+		for _, replicator := range replicators {
 			ctx := log.IntoContext(ctx, log.FromContext(ctx).WithValues("objectKind", replicator.GetKind()))
 			log.FromContext(ctx).V(2).Info("Replicating object kind")
 
 			replicaObjects := replicator.EmptyObjectList()
-			err := r.List(ctx, replicaObjects, &client.ListOptions{
-				Namespace:     namespaceName,
-				LabelSelector: replicaResourcesSelector,
-			})
+			err := r.List(ctx, replicaObjects, client.InNamespace(namespaceName),
+				client.MatchingFields{objectTypeIndexField: objectTypeIndexValueReplica})
 			if err != nil {
 				return ctrl.Result{}, err
 			}
@@ -99,23 +104,49 @@ func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 				}
 				if isNamespaceIgnored {
 					log.FromContext(ctx).V(1).Info("Deleting replica in ignored namespace")
-					err := deleteObject(ctx, r.Client, object)
+					err := deleteObject(ctx, r.Client, replicator.GetKind(), object)
 					if err != nil {
 						errs = append(errs, fmt.Errorf("failed to delete object: %+w", err))
 					}
 				}
+
+				if sourceNamespace, ok := object.GetAnnotations()[sourceNamespaceAnnotationKey]; ok {
+					sourceObject := replicator.EmptyObject()
+					sourceObject.SetNamespace(sourceNamespace)
+					sourceObject.SetName(getReplicaSourceName(object))
+					err := updateReplicationStatus(ctx, r.Client, r.apiReader, replicator.GetKind(), sourceObject,
+						removeReplicaStatus(namespaceName))
+					if err != nil {
+						errs = append(errs, err)
+					}
+				}
 			}
 			if len(errs) > 0 {
 				return ctrl.Result{}, fmt.Errorf("failed to iterate replicated objects in removed namespace: %+v", errs)
 			}
 		}
 	} else {
-		for _, replicator := range r.Replicators {
+		requestList := &v1alpha1.ReplicaRequestList{}
+		if err := r.List(ctx, requestList, client.InNamespace(namespaceName)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to list replica requests: %+w", err)
+		}
+		requestResults := map[string]replicaRequestResult{}
+		for _, request := range requestList.Items {
+			requestResults[replicaRequestKey(request.Spec.Kind, request.Spec.SourceNamespace, request.Spec.Name)] =
+				newFailedReplicaRequestResult(requestConditionReasonSourceNotFound, "source object not found")
+		}
+		setRequestResult := func(key string, result replicaRequestResult) {
+			if _, ok := requestResults[key]; ok {
+				requestResults[key] = result
+			}
+		}
+
+		for _, replicator := range replicators {
 			ctx := log.IntoContext(ctx, log.FromContext(ctx).WithValues("objectKind", replicator.GetKind()))
 			log.FromContext(ctx).V(2).Info("Replicating object kind")
 
 			replicatedObjects := replicator.EmptyObjectList()
-			err := r.List(ctx, replicatedObjects)
+			err := r.List(ctx, replicatedObjects, client.MatchingFields{objectTypeIndexField: objectTypeIndexValueSource})
 			if err != nil {
 				return ctrl.Result{}, err
 			}
@@ -125,18 +156,103 @@ func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 				ctx := log.IntoContext(ctx, log.FromContext(ctx).WithValues("sourceNamespace", object.GetNamespace(),
 					"replicaName", object.GetName()))
 
-				if objectType, objectTypeOk := object.GetLabels()[objectTypeLabelKey]; objectTypeOk && objectType == objectTypeLabelValueReplicated {
+				objectType := object.GetLabels()[objectTypeLabelKey]
+				if objectType == objectTypeLabelValueReplicated || objectType == objectTypeLabelValueRequestable {
 					if object.GetDeletionTimestamp() != nil { // Object already deleted
 						log.FromContext(ctx).V(2).Info("Ignoring deleted object")
 						continue
 					}
+					requestKey := replicaRequestKey(replicator.GetKind(), object.GetNamespace(), object.GetName())
 					if object.GetNamespace() == namespaceName { // Replicated resource is from current namespace
 						log.FromContext(ctx).V(2).Info("Ignoring source object in current namespace")
+						setRequestResult(requestKey, newFailedReplicaRequestResult(requestConditionReasonNamespaceNotTargeted,
+							"source object is in the namespace of the request"))
+						continue
+					}
+					_, isRequested := requestResults[requestKey]
+					if isRequestable(object) && !isRequested {
+						err := deleteReplica(ctx, r.Client, r.recorder, namespaceName, object, replicator,
+							"source object not requested by namespace")
+						if err != nil {
+							errs = append(errs, err)
+						}
+						err = updateReplicationStatus(ctx, r.Client, r.apiReader, replicator.GetKind(), object,
+							removeReplicaStatus(namespaceName))
+						if err != nil {
+							errs = append(errs, err)
+						}
+						continue
+					}
+					if !isRequestable(object) {
+						// Sources which are not requestable are replicated regardless of the requests
+						setRequestResult(requestKey, newFailedReplicaRequestResult(requestConditionReasonSourceNotRequestable,
+							"source object does not allow requests"))
+					}
+
+					isAllowed, err := isSourceAllowed(ctx, r.Client, replicator.GetKind(), object.GetNamespace())
+					if err != nil {
+						errs = append(errs, err)
+						continue
+					}
+					if !isAllowed {
+						setRequestResult(requestKey, newFailedReplicaRequestResult(requestConditionReasonSourceNotAllowed,
+							"source object is not allowed by cluster replication policies"))
+						err := deleteReplica(ctx, r.Client, r.recorder, namespaceName, object, replicator,
+							"source object not allowed by cluster replication policies")
+						if err != nil {
+							errs = append(errs, err)
+						}
+						err = updateReplicationStatus(ctx, r.Client, r.apiReader, replicator.GetKind(), object,
+							removeReplicaStatus(namespaceName))
+						if err != nil {
+							errs = append(errs, err)
+						}
+						continue
+					}
+
+					matcher, err := newTargetNamespaceMatcher(object)
+					if err != nil {
+						log.FromContext(ctx).V(1).Info("Ignoring source object with invalid target namespaces",
+							"error", err.Error())
+						setRequestResult(requestKey, newReplicaRequestResult(err))
+						continue
+					}
+					if _, err := validateSourceReplicaOptions(object, namespaceName); err != nil {
+						log.FromContext(ctx).V(1).Info("Ignoring source object with invalid replica options",
+							"error", err.Error())
+						setRequestResult(requestKey, newReplicaRequestResult(err))
+						continue
+					}
+					if !matcher.Matches(namespace) {
+						setRequestResult(requestKey, newFailedReplicaRequestResult(requestConditionReasonNamespaceNotTargeted,
+							"namespace is not targeted by the source object"))
+						err := deleteReplica(ctx, r.Client, r.recorder, namespaceName, object, replicator,
+							"namespace not targeted by source object")
+						if err != nil {
+							errs = append(errs, err)
+						}
+						err = updateReplicationStatus(ctx, r.Client, r.apiReader, replicator.GetKind(), object,
+							removeReplicaStatus(namespaceName))
+						if err != nil {
+							errs = append(errs, err)
+						}
 						continue
 					}
 
 					log.FromContext(ctx).V(1).Info("Creating/Updating replica")
-					err := replicateObject(ctx, r.Client, r.recorder, namespaceName, object, replicator)
+					err = replicateObject(ctx, r.Client, r.recorder, namespaceName, object, replicator, replicaOptions{
+						conflictPolicy: getSourceConflictPolicy(object),
+						apiReader:      r.apiReader,
+					})
+					replicaStatus := newReplicaStatus(namespaceName, object, err)
+					if isRequestable(object) {
+						setRequestResult(requestKey, newReplicaRequestResult(err))
+					}
+					if err := ignoreReplicaConflict(err); err != nil {
+						errs = append(errs, err)
+					}
+					err = updateReplicationStatus(ctx, r.Client, r.apiReader, replicator.GetKind(), object,
+						setReplicaStatuses([]v1alpha1.ReplicaStatus{replicaStatus}, false))
 					if err != nil {
 						errs = append(errs, err)
 					}
@@ -146,18 +262,39 @@ func (r *NamespaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 				return ctrl.Result{}, fmt.Errorf("failed to iterate replicated objects in namespace: %+v", errs)
 			}
 		}
+
+		errs := []error{}
+		for i := range requestList.Items {
+			request := &requestList.Items[i]
+			result := requestResults[replicaRequestKey(request.Spec.Kind, request.Spec.SourceNamespace, request.Spec.Name)]
+			if err := updateReplicaRequestStatus(ctx, r.Client, request, result); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return ctrl.Result{}, fmt.Errorf("failed to update replica requests in namespace: %+v", errs)
+		}
 	}
 	return ctrl.Result{}, nil
 }
 
+// mapRequestToNamespace reconciles the namespace of a replica request when it is added, changed or removed
+func mapRequestToNamespace(_ context.Context, request client.Object) []reconcile.Request {
+	return []reconcile.Request{
+		{NamespacedName: client.ObjectKey{Name: request.GetNamespace()}},
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	isReconciled := func(object client.Object) bool {
 		return !isNamespaceIgnored(object.(*corev1.Namespace))
 	}
-	predicate := predicate.Funcs{
+	namespacePredicate := predicate.Funcs{
 		CreateFunc: func(ce event.CreateEvent) bool {
-			return isReconciled(ce.Object)
+			// Ignored namespaces are reconciled when first seen to remove any replicas left behind in them
+			// (e.g. by namespaces excluded since the operator was last started)
+			return true
 		},
 		UpdateFunc: func(ue event.UpdateEvent) bool {
 			return isReconciled(ue.ObjectOld) || isReconciled(ue.ObjectNew)
@@ -172,15 +309,25 @@ func (r *NamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	name := "replicator-namespace-controller"
 	r.recorder = mgr.GetEventRecorderFor(name)
+	r.apiReader = mgr.GetAPIReader()
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
 	if r.Scheme == nil {
 		r.Scheme = mgr.GetScheme()
 	}
+	if err := setupReplicationStatusCache(mgr); err != nil {
+		return err
+	}
+	// The index is used by the replication controllers as well, which are always set up along with this controller
+	if err := setupReplicaRequestIndex(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		For(&corev1.Namespace{}, builder.WithPredicates(predicate)).
+		For(&corev1.Namespace{}, builder.WithPredicates(namespacePredicate)).
+		Watches(&v1alpha1.ReplicaRequest{}, handler.EnqueueRequestsFromMapFunc(mapRequestToNamespace),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		WithOptions(newManagerOptions(mgr, name, "Namespace")).
-		Complete(r)
+		Complete(newTracingReconciler(name, r))
 }
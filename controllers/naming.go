@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// replicaNameTemplateData is the data available to the target name templates of source objects
+type replicaNameTemplateData struct {
+	// Name is the name of the source object
+	Name string
+	// SourceNamespace is the namespace of the source object
+	SourceNamespace string
+	// TargetNamespace is the namespace the replica is created in
+	TargetNamespace string
+}
+
+// getReplicaName returns the name of the replica of a source object in a target namespace. The
+// replica keeps the name of the source object unless a target name template is specified.
+func getReplicaName(sourceObject client.Object, targetNamespace string) (string, error) {
+	nameTemplate, ok := sourceObject.GetAnnotations()[targetNameAnnotationKey]
+	if !ok {
+		return sourceObject.GetName(), nil
+	}
+
+	tmpl, err := template.New("target-name").Option("missingkey=error").Parse(nameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s annotation %q: %+w", targetNameAnnotationKey, nameTemplate, err)
+	}
+	name := &strings.Builder{}
+	err = tmpl.Execute(name, replicaNameTemplateData{
+		Name:            sourceObject.GetName(),
+		SourceNamespace: sourceObject.GetNamespace(),
+		TargetNamespace: targetNamespace,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render %s annotation %q: %+w", targetNameAnnotationKey, nameTemplate, err)
+	}
+	if errs := validation.IsDNS1123Subdomain(name.String()); len(errs) > 0 {
+		return "", fmt.Errorf("invalid target name %q: %s", name.String(), strings.Join(errs, ", "))
+	}
+	return name.String(), nil
+}
+
+// getReplicaSourceName returns the name of the source object of a replica. Replicas created before
+// source names were recorded always share the name of their source object.
+func getReplicaSourceName(replica client.Object) string {
+	if sourceName, ok := replica.GetAnnotations()[sourceNameAnnotationKey]; ok {
+		return sourceName
+	}
+	return replica.GetName()
+}
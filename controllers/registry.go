@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+)
+
+// ReplicatorRegistry holds the replicators of the kinds added at runtime using ReplicatedKinds.
+// The registry is shared between the controllers, and hence is safe for concurrent use.
+type ReplicatorRegistry struct {
+	lock        sync.RWMutex
+	replicators map[string]replication.Replicator
+}
+
+// NewReplicatorRegistry creates an empty replicator registry
+func NewReplicatorRegistry() *ReplicatorRegistry {
+	return &ReplicatorRegistry{
+		replicators: map[string]replication.Replicator{},
+	}
+}
+
+// Add registers the replicator of a kind, replacing the replicator previously registered for the kind
+func (r *ReplicatorRegistry) Add(replicator replication.Replicator) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.replicators[replicator.GetKind()] = replicator
+}
+
+// Remove unregisters the replicator of a kind
+func (r *ReplicatorRegistry) Remove(kind string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.replicators, kind)
+}
+
+// List returns the registered replicators sorted by kind. A nil registry does not hold any replicators.
+func (r *ReplicatorRegistry) List() []replication.Replicator {
+	if r == nil {
+		return nil
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	replicators := make([]replication.Replicator, 0, len(r.replicators))
+	for _, replicator := range r.replicators {
+		replicators = append(replicators, replicator)
+	}
+	sort.Slice(replicators, func(i, j int) bool {
+		return replicators[i].GetKind() < replicators[j].GetKind()
+	})
+	return replicators
+}
+
+// withRegisteredReplicators appends the replicators registered at runtime to the static replicators
+func withRegisteredReplicators(replicators []replication.Replicator, registry *ReplicatorRegistry) []replication.Replicator {
+	registered := registry.List()
+	if len(registered) == 0 {
+		return replicators
+	}
+	all := make([]replication.Replicator, 0, len(replicators)+len(registered))
+	all = append(all, replicators...)
+	return append(all, registered...)
+}
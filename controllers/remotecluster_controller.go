@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// RemoteClusterReconciler reconciles the kubeconfig Secrets defining the remote clusters, and removes
+// the replicas from a remote cluster before its kubeconfig Secret is deleted
+type RemoteClusterReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	recorder record.EventRecorder
+
+	// Replicators are the replicators of the kinds replicated since the operator started
+	Replicators []replication.Replicator
+	// RegisteredReplicators holds the replicators of the kinds added at runtime (optional)
+	RegisteredReplicators *ReplicatorRegistry
+	// RemoteClusters holds the clients of the remote clusters
+	RemoteClusters *RemoteClusterRegistry
+}
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *RemoteClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).V(1).WithValues("cluster", req.Name))
+	log.FromContext(ctx).V(2).Info("Reconciling remote cluster")
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, req.NamespacedName, secret); err != nil {
+		if errors.IsNotFound(err) {
+			r.RemoteClusters.forget(req.Name)
+			return ctrl.Result{}, nil
+		} else {
+			return ctrl.Result{}, fmt.Errorf("failed to get remote cluster secret being reconciled: %+w", err)
+		}
+	}
+
+	if secret.GetDeletionTimestamp() != nil || !r.RemoteClusters.isClusterSecret(secret) {
+		return ctrl.Result{}, r.handleClusterRemoval(ctx, secret)
+	}
+	return ctrl.Result{}, r.handleClusterUpdate(ctx, secret)
+}
+
+func (r *RemoteClusterReconciler) handleClusterRemoval(ctx context.Context, secret *corev1.Secret) error {
+	if !controllerutil.ContainsFinalizer(secret, clusterFinalizer) {
+		return nil
+	}
+
+	cluster, err := r.RemoteClusters.get(secret, r.Scheme)
+	if err != nil {
+		// Replicas cannot be created using an invalid kubeconfig, and hence cannot be removed either
+		log.FromContext(ctx).Error(err, "Unable to remove replicas from remote cluster with invalid kubeconfig")
+	} else {
+		errs := []error{}
+		for _, replicator := range withRegisteredReplicators(r.Replicators, r.RegisteredReplicators) {
+			if err := r.deleteReplicas(ctx, cluster, replicator); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("failed to finalize remote cluster: %+v", errs)
+		}
+	}
+
+	r.RemoteClusters.forget(secret.GetName())
+	controllerutil.RemoveFinalizer(secret, clusterFinalizer)
+	err = retryOperation(ctx, func() error {
+		return r.Update(ctx, secret)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove finalizer: %+w", err)
+	}
+	return nil
+}
+
+func (r *RemoteClusterReconciler) handleClusterUpdate(ctx context.Context, secret *corev1.Secret) error {
+	if !controllerutil.ContainsFinalizer(secret, clusterFinalizer) {
+		controllerutil.AddFinalizer(secret, clusterFinalizer)
+		err := retryOperation(ctx, func() error {
+			return r.Update(ctx, secret)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add finalizer: %+w", err)
+		}
+	}
+
+	if _, err := r.RemoteClusters.get(secret, r.Scheme); err != nil {
+		log.FromContext(ctx).Error(err, "Ignoring remote cluster with invalid kubeconfig")
+		r.recorder.Eventf(secret, "Warning", InvalidKubeconfig, "invalid kubeconfig: %v", err)
+	}
+	return nil
+}
+
+// deleteReplicas deletes all the replicas of a kind from a remote cluster
+func (r *RemoteClusterReconciler) deleteReplicas(ctx context.Context, cluster *remoteCluster,
+	replicator replication.Replicator) error {
+	replicaList := replicator.EmptyObjectList()
+	err := cluster.client.List(ctx, replicaList,
+		client.MatchingLabels{objectTypeLabelKey: objectTypeLabelValueRemoteReplica})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// The kind is not served by the remote cluster, and hence it does not contain any replicas
+			return nil
+		}
+		return fmt.Errorf("failed to list %s replicas: %+w", replicator.GetKind(), err)
+	}
+
+	for _, replica := range replicator.ObjectListToArray(replicaList) {
+		log.FromContext(ctx).V(1).Info("Deleting replica", "replicaNamespace", replica.GetNamespace(),
+			"replicaName", replica.GetName(), "replicaKind", replicator.GetKind(), "reason", "remote cluster removed")
+		if err := deleteObject(ctx, cluster.client, replicator.GetKind(), replica); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RemoteClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	name := "replicator-remotecluster-controller"
+	r.recorder = mgr.GetEventRecorderFor(name)
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Scheme == nil {
+		r.Scheme = mgr.GetScheme()
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&corev1.Secret{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			return r.RemoteClusters.isClusterSecret(object) || controllerutil.ContainsFinalizer(object, clusterFinalizer)
+		}))).
+		WithOptions(newManagerOptions(mgr, name, "Secret")).
+		Complete(newTracingReconciler(name, r))
+}
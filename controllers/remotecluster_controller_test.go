@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("Remote Cluster", func() {
+	var sourceNamespace *corev1.Namespace
+	var remoteNamespaces []*corev1.Namespace
+	var clusterSecret *corev1.Secret
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+
+		remoteNamespaces = []*corev1.Namespace{}
+		for i := 0; i < 2; i++ {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "remote-ns-" + uuid.New().String(),
+				},
+			}
+			Expect(remoteK8sClient.Create(ctx, ns)).To(Succeed())
+			remoteNamespaces = append(remoteNamespaces, ns)
+		}
+
+		clusterSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "spoke-" + uuid.New().String(),
+				Namespace: remoteClustersNamespace,
+				Labels: map[string]string{
+					secretTypeLabelKey: secretTypeLabelValueKubeconfig,
+				},
+			},
+			Data: map[string][]byte{
+				kubeconfigSecretKey: remoteKubeconfig,
+			},
+		}
+		Expect(k8sClient.Create(ctx, clusterSecret)).To(Succeed())
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, clusterSecret))).To(Succeed())
+		clusterSecret = nil
+
+		for _, ns := range remoteNamespaces {
+			Expect(remoteK8sClient.Delete(ctx, ns)).To(Succeed())
+		}
+		remoteNamespaces = nil
+
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+	})
+
+	newSourceObject := func(targetClusters string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-secret-" + uuid.New().String(),
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+				Annotations: map[string]string{
+					targetClustersAnnotationKey: targetClusters,
+				},
+			},
+			StringData: map[string]string{
+				"password": "remote-password",
+			},
+		}
+	}
+
+	validateRemoteReplication := func(ctx context.Context, sourceObject client.Object) {
+		for _, ns := range remoteNamespaces {
+			Eventually(func() bool {
+				replica := &corev1.Secret{}
+				err := remoteK8sClient.Get(ctx, replicaKey(sourceObject, ns), replica)
+				if err != nil {
+					return false
+				}
+				return replica.GetLabels()[objectTypeLabelKey] == objectTypeLabelValueRemoteReplica &&
+					replica.GetLabels()[sourceUIDLabelKey] == string(sourceObject.GetUID()) &&
+					replica.GetAnnotations()[sourceNamespaceAnnotationKey] == sourceObject.GetNamespace() &&
+					!controllerutil.ContainsFinalizer(replica, resourceFinalizer) &&
+					string(replica.Data["password"]) == "remote-password"
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+	}
+
+	validateRemoteReplicaRemoval := func(ctx context.Context, sourceObject client.Object) {
+		for _, ns := range remoteNamespaces {
+			Eventually(func() bool {
+				err := remoteK8sClient.Get(ctx, replicaKey(sourceObject, ns), &corev1.Secret{})
+				return err != nil && errors.IsNotFound(err)
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+	}
+
+	It("Should replicate sources into the targeted remote clusters", func(ctx SpecContext) {
+		sourceObject := newSourceObject(clusterSecret.GetName())
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		validateRemoteReplication(ctx, sourceObject)
+
+		By("deleting the source object")
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+		validateRemoteReplicaRemoval(ctx, sourceObject)
+	}, testTimeout)
+
+	It("Should not replicate sources into remote clusters which are not targeted", func(ctx SpecContext) {
+		sourceObject := newSourceObject("other-cluster")
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+		for _, ns := range remoteNamespaces {
+			Consistently(func() bool {
+				err := remoteK8sClient.Get(ctx, replicaKey(sourceObject, ns), &corev1.Secret{})
+				return err != nil && errors.IsNotFound(err)
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+
+	It("Should recreate the remote replicas deleted directly", func(ctx SpecContext) {
+		sourceObject := newSourceObject(clusterSecret.GetName())
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		validateRemoteReplication(ctx, sourceObject)
+
+		By("deleting the remote replicas")
+		for _, ns := range remoteNamespaces {
+			key := replicaKey(sourceObject, ns)
+			Expect(remoteK8sClient.Delete(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      key.Name,
+					Namespace: key.Namespace,
+				},
+			})).To(Succeed())
+		}
+		validateRemoteReplicaRemoval(ctx, sourceObject)
+
+		By("updating the source object")
+		Eventually(func() error {
+			latestSourceObject := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject); err != nil {
+				return err
+			}
+			setAnnotation(latestSourceObject, "test-annotation", "updated")
+			return k8sClient.Update(ctx, latestSourceObject)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		validateRemoteReplication(ctx, sourceObject)
+
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+		validateRemoteReplicaRemoval(ctx, sourceObject)
+	}, testTimeout)
+
+	It("Should remove the replicas once the remote cluster is no longer targeted", func(ctx SpecContext) {
+		sourceObject := newSourceObject(clusterSecret.GetName())
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		validateRemoteReplication(ctx, sourceObject)
+
+		By("removing the remote cluster from the target clusters")
+		Eventually(func() error {
+			latestSourceObject := &corev1.Secret{}
+			if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject); err != nil {
+				return err
+			}
+			setAnnotation(latestSourceObject, targetClustersAnnotationKey, "")
+			return k8sClient.Update(ctx, latestSourceObject)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		validateRemoteReplicaRemoval(ctx, sourceObject)
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+
+	It("Should remove the replicas from the remote cluster once it is removed", func(ctx SpecContext) {
+		sourceObject := newSourceObject(clusterSecret.GetName())
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		validateRemoteReplication(ctx, sourceObject)
+
+		By("deleting the remote cluster secret")
+		Expect(k8sClient.Delete(ctx, clusterSecret)).To(Succeed())
+		validateRemoteReplicaRemoval(ctx, sourceObject)
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(clusterSecret), &corev1.Secret{})
+			return err != nil && errors.IsNotFound(err)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+})
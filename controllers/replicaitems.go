@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// replicaItem is the replication of a source object into a single target namespace. The namespaces
+// which fail while reconciling a source object are retried as replica items, each with its own backoff,
+// so that a failing namespace does not cause the source to be replicated into all the namespaces again.
+type replicaItem struct {
+	source          types.NamespacedName
+	targetNamespace string
+}
+
+// retryReplicaItem queues the replication of a source object into a namespace to be retried after the
+// backoff of the namespace. False is returned if the replica items are not processed (e.g. the
+// controller is not started yet), in which case the source object needs to be retried instead.
+func (r *ReplicationReconciler) retryReplicaItem(object client.Object, ns string) bool {
+	r.replicaQueueLock.RLock()
+	defer r.replicaQueueLock.RUnlock()
+	if r.replicaQueue == nil || r.replicaQueue.ShuttingDown() {
+		return false
+	}
+	r.replicaQueue.AddRateLimited(replicaItem{
+		source:          client.ObjectKeyFromObject(object),
+		targetNamespace: ns,
+	})
+	return true
+}
+
+// reconcileReplicaItem replicates a source object into a single namespace, or deletes its replica if the
+// namespace is no longer targeted by the source object
+func (r *ReplicationReconciler) reconcileReplicaItem(ctx context.Context, item replicaItem) (ctrl.Result, error) {
+	ctx, span := startSpan(ctx, r.replicaItemControllerName()+" Reconcile",
+		namespaceAttributeKey.String(item.targetNamespace), nameAttributeKey.String(item.source.Name))
+	err := r.syncReplicaItem(ctx, item)
+	endSpan(span, err)
+	return ctrl.Result{}, err
+}
+
+func (r *ReplicationReconciler) syncReplicaItem(ctx context.Context, item replicaItem) error {
+	// Sources which are removed, or cannot be replicated, are handled by the reconciles of the sources
+	object := r.Replicator.EmptyObject()
+	if err := r.Get(ctx, item.source, object); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if object.GetDeletionTimestamp() != nil || object.GetNamespace() == item.targetNamespace ||
+		(!hasObjectType(object, objectTypeLabelValueReplicated) && !isRequestable(object)) {
+		return nil
+	}
+	isAllowed, err := isSourceAllowed(ctx, r.Client, r.Replicator.GetKind(), object.GetNamespace())
+	if err != nil {
+		return err
+	}
+	matcher, err := newTargetNamespaceMatcher(object)
+	if !isAllowed || err != nil {
+		return nil
+	}
+	if _, err := validateSourceReplicaOptions(object, object.GetNamespace()); err != nil {
+		return nil
+	}
+
+	// Namespaces which are removed, or cannot be replicated into, are handled by the namespace controller
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: item.targetNamespace}, ns); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if isNamespaceIgnored(ns) || ns.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	requests := []*v1alpha1.ReplicaRequest{}
+	if isRequestable(object) {
+		requests, err = findReplicaRequests(ctx, r.Client, ns.GetName(), r.Replicator.GetKind(), object)
+		if err != nil {
+			return err
+		}
+	}
+
+	status, err := r.syncNamespace(ctx, object, matcher, ns, len(requests) > 0)
+	if status == nil {
+		return err
+	}
+	if ignoreReplicaConflict(err) != nil {
+		// The failed item is queued to be retried after its backoff
+		*status = newRetriedReplicaStatus(ns.GetName(), err)
+	}
+	statusErrs := []error{}
+	for _, request := range requests {
+		requestErr := updateReplicaRequestStatus(ctx, r.Client, request, newReplicaRequestResult(err))
+		if requestErr != nil {
+			statusErrs = append(statusErrs, requestErr)
+		}
+	}
+	statusErr := updateReplicationStatus(ctx, r.Client, r.apiReader, r.Replicator.GetKind(), object,
+		setReplicaStatuses([]v1alpha1.ReplicaStatus{*status}, false))
+	if statusErr != nil {
+		statusErrs = append(statusErrs, statusErr)
+	}
+	if err := ignoreReplicaConflict(err); err != nil {
+		return err
+	}
+	if len(statusErrs) > 0 {
+		return fmt.Errorf("failed to update statuses of replica: %+v", statusErrs)
+	}
+	return nil
+}
+
+func (r *ReplicationReconciler) replicaItemControllerName() string {
+	return fmt.Sprintf("replicator-%s-replica-controller", strings.ToLower(r.Replicator.GetKind()))
+}
+
+// newReplicaItemController creates the controller processing the replica items of the kind, which
+// uses its own rate limited queue for backing off from the failing namespaces
+func (r *ReplicationReconciler) newReplicaItemController(mgr ctrl.Manager,
+	isManaged bool) (controller.TypedController[replicaItem], error) {
+	name := r.replicaItemControllerName()
+	kind := r.Replicator.GetKind()
+	logger := mgr.GetLogger().WithValues(
+		"controller", name,
+	)
+	sourceOptions := newManagerOptions(mgr, name, kind)
+	options := controller.TypedOptions[replicaItem]{
+		// Only the failing namespaces are retried as items, and hence they are retried with the parallelism
+		// of a single reconcile to avoid competing with the source reconciles for the API server
+		MaxConcurrentReconciles: namespaceParallelism,
+		RecoverPanic:            sourceOptions.RecoverPanic,
+		NeedLeaderElection:      sourceOptions.NeedLeaderElection,
+		Reconciler:              reconcile.TypedFunc[replicaItem](r.reconcileReplicaItem),
+		LogConstructor: func(item *replicaItem) logr.Logger {
+			logger := logger
+			if item != nil {
+				logger = logger.WithValues(
+					"reconcileObject", klog.KRef(item.source.Namespace, item.source.Name),
+					"reconcileKind", kind,
+					"replicaNamespace", item.targetNamespace,
+				)
+			}
+			return logger
+		},
+	}
+
+	var c controller.TypedController[replicaItem]
+	var err error
+	if isManaged {
+		c, err = controller.NewTyped(name, mgr, options)
+	} else {
+		options.SkipNameValidation = ptr.To(true) // The controller is re-created whenever the kind is updated
+		options.DefaultFromConfig(mgr.GetControllerOptions())
+		c, err = controller.NewTypedUnmanaged(name, options)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replica controller: %+w", err)
+	}
+
+	// The queue of the controller is only created once the controller is started
+	err = c.Watch(source.TypedFunc[replicaItem](func(_ context.Context,
+		queue workqueue.TypedRateLimitingInterface[replicaItem]) error {
+		r.replicaQueueLock.Lock()
+		defer r.replicaQueueLock.Unlock()
+		r.replicaQueue = queue
+		return nil
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch replica items: %+w", err)
+	}
+	return c, nil
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var _ = Describe("Replica Items", func() {
+	var sourceNamespace *corev1.Namespace
+	nc := namespaceCreator{}
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+
+		nc.Cleanup(ctx)
+	})
+
+	getReplicaState := func(ctx context.Context, sourceObject client.Object, ns *corev1.Namespace) v1alpha1.ReplicaState {
+		replicationStatus := &v1alpha1.ReplicationStatus{}
+		err := k8sClient.Get(ctx, client.ObjectKey{
+			Namespace: sourceObject.GetNamespace(),
+			Name:      replicationStatusName("ConfigMap", sourceObject.GetName()),
+		}, replicationStatus)
+		if err != nil {
+			return ""
+		}
+		replicaStatus := findReplicaStatus(replicationStatus.Status.Replicas, ns.GetName())
+		if replicaStatus == nil {
+			return ""
+		}
+		return replicaStatus.State
+	}
+
+	getReconcileErrors := func(controllerName string) float64 {
+		families, err := metrics.Registry.Gather()
+		Expect(err).NotTo(HaveOccurred())
+		for _, family := range families {
+			if family.GetName() != "controller_runtime_reconcile_errors_total" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				for _, label := range metric.GetLabel() {
+					if label.GetName() == "controller" && label.GetValue() == controllerName {
+						return metric.GetCounter().GetValue()
+					}
+				}
+			}
+		}
+		return 0
+	}
+
+	It("Should retry failing namespaces without failing the source", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+		brokenNamespace := targetNamespaces[0]
+		// The quota does not allow any config maps to be created in the namespace
+		quota := &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-quota",
+				Namespace: brokenNamespace.GetName(),
+			},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{
+					"count/configmaps": resource.MustParse("0"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, quota)).To(Succeed())
+		initialReplicaErrors := getReconcileErrors("replicator-configmap-replica-controller")
+
+		sourceObject := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-config-map-" + uuid.New().String(),
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			Data: map[string]string{
+				"endpoint": "https://api.example.com",
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		for _, ns := range targetNamespaces[1:] {
+			Eventually(func() v1alpha1.ReplicaState {
+				return getReplicaState(ctx, sourceObject, ns)
+			}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(v1alpha1.ReplicaStateSynced))
+		}
+		Eventually(func() v1alpha1.ReplicaState {
+			return getReplicaState(ctx, sourceObject, brokenNamespace)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(v1alpha1.ReplicaStateFailed))
+		Eventually(func() float64 {
+			return getReconcileErrors("replicator-configmap-replica-controller")
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeNumerically(">", initialReplicaErrors))
+
+		By("removing the quota")
+		Expect(k8sClient.Delete(ctx, quota)).To(Succeed())
+		Eventually(func() v1alpha1.ReplicaState {
+			return getReplicaState(ctx, sourceObject, brokenNamespace)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(v1alpha1.ReplicaStateSynced))
+		Expect(k8sClient.Get(ctx, replicaKey(sourceObject, brokenNamespace), &corev1.ConfigMap{})).To(Succeed())
+
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+})
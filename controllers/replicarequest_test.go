@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Replica Request", func() {
+	var sourceNamespace *corev1.Namespace
+	nc := namespaceCreator{}
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+
+		nc.Cleanup(ctx)
+	})
+
+	newSourceObject := func(objectType string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-config-map-" + uuid.New().String(),
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectType,
+				},
+			},
+			Data: map[string]string{
+				"endpoint": "https://api.example.com",
+			},
+		}
+	}
+
+	newRequest := func(ns *corev1.Namespace, sourceObject client.Object) *v1alpha1.ReplicaRequest {
+		return &v1alpha1.ReplicaRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-request-" + uuid.New().String(),
+				Namespace: ns.GetName(),
+			},
+			Spec: v1alpha1.ReplicaRequestSpec{
+				Kind:            "ConfigMap",
+				SourceNamespace: sourceObject.GetNamespace(),
+				Name:            sourceObject.GetName(),
+			},
+		}
+	}
+
+	isReplicated := func(ctx context.Context, sourceObject client.Object, ns *corev1.Namespace) bool {
+		replica := &corev1.ConfigMap{}
+		err := k8sClient.Get(ctx, replicaKey(sourceObject, ns), replica)
+		return err == nil && isReplicaOf(replica, sourceObject)
+	}
+
+	It("Should replicate requestable sources only into the requesting namespaces", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		sourceObject := newSourceObject(objectTypeLabelValueRequestable)
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+		request := newRequest(targetNamespaces[0], sourceObject)
+		Expect(k8sClient.Create(ctx, request)).To(Succeed())
+		Eventually(func() bool {
+			return isReplicated(ctx, sourceObject, targetNamespaces[0])
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		validateReplicaRequestCondition(ctx, request, metav1.ConditionTrue, requestConditionReasonReplicated)
+		Consistently(func() bool {
+			return isReplicated(ctx, sourceObject, targetNamespaces[1])
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeFalse())
+
+		By("deleting the replica request")
+		Expect(k8sClient.Delete(ctx, request)).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, replicaKey(sourceObject, targetNamespaces[0]), &corev1.ConfigMap{})
+			return err != nil && errors.IsNotFound(err)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+
+	It("Should replicate requestable sources created after the request", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 1, nil)
+		sourceObject := newSourceObject(objectTypeLabelValueRequestable)
+
+		request := newRequest(targetNamespaces[0], sourceObject)
+		Expect(k8sClient.Create(ctx, request)).To(Succeed())
+		validateReplicaRequestCondition(ctx, request, metav1.ConditionFalse, requestConditionReasonSourceNotFound)
+
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		Eventually(func() bool {
+			return isReplicated(ctx, sourceObject, targetNamespaces[0])
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		validateReplicaRequestCondition(ctx, request, metav1.ConditionTrue, requestConditionReasonReplicated)
+
+		By("deleting the source object")
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+		validateReplicaRequestCondition(ctx, request, metav1.ConditionFalse, requestConditionReasonSourceUnavailable)
+		Expect(k8sClient.Delete(ctx, request)).To(Succeed())
+	}, testTimeout)
+
+	It("Should report requests for sources which do not allow requests", func(ctx SpecContext) {
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 1, nil)
+		sourceObject := newSourceObject(objectTypeLabelValueReplicated)
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+		request := newRequest(targetNamespaces[0], sourceObject)
+		Expect(k8sClient.Create(ctx, request)).To(Succeed())
+		validateReplicaRequestCondition(ctx, request, metav1.ConditionFalse, requestConditionReasonSourceNotRequestable)
+
+		Expect(k8sClient.Delete(ctx, request)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+})
+
+func validateReplicaRequestCondition(ctx context.Context, request *v1alpha1.ReplicaRequest,
+	status metav1.ConditionStatus, reason string) {
+	Eventually(func() bool {
+		latestRequest := &v1alpha1.ReplicaRequest{}
+		err := k8sClient.Get(ctx, client.ObjectKeyFromObject(request), latestRequest)
+		if err != nil {
+			return false
+		}
+		condition := meta.FindStatusCondition(latestRequest.Status.Conditions, v1alpha1.ReplicaRequestConditionReady)
+		return condition != nil && condition.Status == status && condition.Reason == reason
+	}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+}
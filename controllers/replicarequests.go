@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	requestConditionReasonReplicated           = "Replicated"
+	requestConditionReasonConflict             = "ReplicaConflict"
+	requestConditionReasonFailed               = "ReplicationFailed"
+	requestConditionReasonSourceNotFound       = "SourceNotFound"
+	requestConditionReasonSourceNotRequestable = "SourceNotRequestable"
+	requestConditionReasonSourceNotAllowed     = "SourceNotAllowed"
+	requestConditionReasonSourceUnavailable    = "SourceUnavailable"
+	requestConditionReasonNamespaceNotTargeted = "NamespaceNotTargeted"
+
+	// replicaRequestSourceIndexField indexes the replica requests by the source objects they request
+	replicaRequestSourceIndexField = groupFqn + "/requested-source"
+)
+
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicarequests,verbs=get;list;watch
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicarequests/status,verbs=get;update;patch
+
+// replicaRequestResult is the outcome of a replica request reported using its ready condition
+type replicaRequestResult struct {
+	status  metav1.ConditionStatus
+	reason  string
+	message string
+}
+
+// newReplicaRequestResult builds the outcome of a replica request based on the result of replicating
+// the requested source object
+func newReplicaRequestResult(err error) replicaRequestResult {
+	if err != nil {
+		reason := requestConditionReasonFailed
+		if isReplicaConflictError(err) {
+			reason = requestConditionReasonConflict
+		}
+		return replicaRequestResult{status: metav1.ConditionFalse, reason: reason, message: err.Error()}
+	}
+	return replicaRequestResult{
+		status:  metav1.ConditionTrue,
+		reason:  requestConditionReasonReplicated,
+		message: "source object replicated into the namespace",
+	}
+}
+
+func newFailedReplicaRequestResult(reason string, message string) replicaRequestResult {
+	return replicaRequestResult{status: metav1.ConditionFalse, reason: reason, message: message}
+}
+
+// isRequestable checks whether a source object is only replicated into the namespaces requesting it
+func isRequestable(object client.Object) bool {
+	return hasObjectType(object, objectTypeLabelValueRequestable)
+}
+
+// replicaRequestKey identifies the source object requested by a replica request
+func replicaRequestKey(kind string, sourceNamespace string, name string) string {
+	return kind + "/" + sourceNamespace + "/" + name
+}
+
+// setupReplicaRequestIndex adds the field index used for looking up the replica requests of a source
+// object in the cache
+func setupReplicaRequestIndex(ctx context.Context, indexer client.FieldIndexer) error {
+	err := indexer.IndexField(ctx, &v1alpha1.ReplicaRequest{}, replicaRequestSourceIndexField,
+		func(object client.Object) []string {
+			request := object.(*v1alpha1.ReplicaRequest)
+			return []string{replicaRequestKey(request.Spec.Kind, request.Spec.SourceNamespace, request.Spec.Name)}
+		})
+	if err != nil {
+		return fmt.Errorf("failed to index replica requests by source object: %+w", err)
+	}
+	return nil
+}
+
+// findReplicaRequests lists the replica requests in a namespace (or all namespaces if empty) which
+// request a source object
+func findReplicaRequests(ctx context.Context, k8sClient client.Client, namespace string, kind string,
+	sourceObject client.Object) ([]*v1alpha1.ReplicaRequest, error) {
+	requestList := &v1alpha1.ReplicaRequestList{}
+	err := k8sClient.List(ctx, requestList, client.InNamespace(namespace), client.MatchingFields{
+		replicaRequestSourceIndexField: replicaRequestKey(kind, sourceObject.GetNamespace(), sourceObject.GetName()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replica requests: %+w", err)
+	}
+
+	requests := []*v1alpha1.ReplicaRequest{}
+	for i := range requestList.Items {
+		requests = append(requests, &requestList.Items[i])
+	}
+	return requests, nil
+}
+
+// updateReplicaRequestStatus records the outcome of a replica request in its ready condition
+func updateReplicaRequestStatus(ctx context.Context, k8sClient client.Client, request *v1alpha1.ReplicaRequest,
+	result replicaRequestResult) error {
+	condition := meta.FindStatusCondition(request.Status.Conditions, v1alpha1.ReplicaRequestConditionReady)
+	if request.Status.ObservedGeneration == request.GetGeneration() && condition != nil &&
+		condition.Status == result.status && condition.Reason == result.reason && condition.Message == result.message {
+		return nil
+	}
+
+	request.Status.ObservedGeneration = request.GetGeneration()
+	meta.SetStatusCondition(&request.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ReplicaRequestConditionReady,
+		Status:             result.status,
+		ObservedGeneration: request.GetGeneration(),
+		Reason:             result.reason,
+		Message:            result.message,
+	})
+	if err := k8sClient.Status().Update(ctx, request); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to update replica request %s/%s status: %+w",
+			request.GetNamespace(), request.GetName(), err)
+	}
+	return nil
+}
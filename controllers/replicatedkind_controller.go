@@ -0,0 +1,402 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	kindConditionReasonStarted  = "ControllerStarted"
+	kindConditionReasonNotFound = "KindNotFound"
+	kindConditionReasonConflict = "KindConflict"
+	kindConditionReasonInvalid  = "InvalidKind"
+	kindConditionReasonFailed   = "ControllerFailed"
+	kindConditionMessageStarted = "controllers replicating the kind are running"
+
+	// kindRetryInterval is the interval after which a kind which cannot be replicated is re-evaluated
+	kindRetryInterval = 30 * time.Second
+	// kindInformerSyncTimeout is the maximum time to wait for the objects of a kind to be cached
+	kindInformerSyncTimeout = 2 * time.Minute
+)
+
+// ReplicatedKindReconciler reconciles a ReplicatedKind object by starting and stopping the controllers
+// replicating the kind
+type ReplicatedKindReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	recorder  record.EventRecorder
+	apiReader client.Reader
+	mgr       ctrl.Manager
+
+	// Replicators are the replicators of the kinds replicated since the operator started
+	Replicators []replication.Replicator
+	// RegisteredReplicators holds the replicators of the kinds with running controllers
+	RegisteredReplicators *ReplicatorRegistry
+	// PolicyReconciler re-evaluates the replication policies selecting objects of the kinds (optional)
+	PolicyReconciler *ReplicationPolicyReconciler
+	// RemoteClusters holds the remote clusters the objects of the kinds can be replicated into (optional)
+	RemoteClusters *RemoteClusterRegistry
+
+	lock sync.Mutex
+	// ctx bounds the lifetime of the started controllers to the lifetime of the manager
+	ctx          context.Context
+	runningKinds map[string]*runningKind
+}
+
+// runningKind holds the controllers started for a replicated kind
+type runningKind struct {
+	generation int64
+	replicator replication.Replicator
+	cancel     context.CancelFunc
+	done       sync.WaitGroup
+}
+
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicatedkinds,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicatedkinds/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicatedkinds/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ReplicatedKindReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).V(1).WithValues("replicatedKind", req.Name))
+	log.FromContext(ctx).V(2).Info("Reconciling replicated kind")
+
+	replicatedKind := &v1alpha1.ReplicatedKind{}
+	if err := r.Get(ctx, req.NamespacedName, replicatedKind); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, r.stopKind(ctx, req.Name)
+		} else {
+			return ctrl.Result{}, fmt.Errorf("failed to get replicated kind being reconciled: %+w", err)
+		}
+	}
+
+	if replicatedKind.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, r.handleKindRemoval(ctx, replicatedKind)
+	} else {
+		return r.handleKindUpdate(ctx, replicatedKind)
+	}
+}
+
+func (r *ReplicatedKindReconciler) handleKindRemoval(ctx context.Context, replicatedKind *v1alpha1.ReplicatedKind) error {
+	if !controllerutil.ContainsFinalizer(replicatedKind, resourceFinalizer) {
+		return nil
+	}
+
+	err := r.stopKind(ctx, replicatedKind.GetName())
+	if err != nil {
+		return fmt.Errorf("failed to finalize replicated kind: %+w", err)
+	}
+	if _, err := r.findKindConflict(replicatedKind); err == nil {
+		// The objects of the kind are only released if they were not managed by another controller
+		err := r.releaseObjects(ctx, newReplicatorConfig(replicatedKind).NewReplicator())
+		if err != nil {
+			return fmt.Errorf("failed to finalize replicated kind: %+w", err)
+		}
+	}
+	return removeFinalizer(ctx, r.Client, replicatedKind)
+}
+
+func (r *ReplicatedKindReconciler) handleKindUpdate(ctx context.Context, replicatedKind *v1alpha1.ReplicatedKind) (ctrl.Result, error) {
+	err := addFinalizer(ctx, r.Client, replicatedKind)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !r.isRunning(replicatedKind.GetName(), replicatedKind.GetGeneration()) {
+		// Controllers started for a previous generation are replaced
+		err = r.stopKind(ctx, replicatedKind.GetName())
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		replicator, reason, err := r.newReplicator(replicatedKind)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Unable to replicate kind")
+			r.recorder.Eventf(replicatedKind, "Warning", InvalidReplicatedKind, "unable to replicate kind: %v", err)
+			err = r.updateReadyCondition(ctx, replicatedKind, metav1.ConditionFalse, reason, err.Error())
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: kindRetryInterval}, nil
+		}
+
+		err = r.startKind(ctx, replicatedKind, replicator)
+		if err != nil {
+			statusErr := r.updateReadyCondition(ctx, replicatedKind, metav1.ConditionFalse, kindConditionReasonFailed, err.Error())
+			if statusErr != nil {
+				log.FromContext(ctx).Error(statusErr, "Failed to report controller failure in replicated kind status")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, r.updateReadyCondition(ctx, replicatedKind, metav1.ConditionTrue, kindConditionReasonStarted,
+		kindConditionMessageStarted)
+}
+
+// newReplicator validates the replicated kind using discovery, and creates the replicator of the kind
+func (r *ReplicatedKindReconciler) newReplicator(replicatedKind *v1alpha1.ReplicatedKind) (replication.Replicator, string, error) {
+	config := newReplicatorConfig(replicatedKind)
+	if err := config.Validate(); err != nil {
+		return nil, kindConditionReasonInvalid, err
+	}
+	if reason, err := r.findKindConflict(replicatedKind); err != nil {
+		return nil, reason, err
+	}
+
+	groupKind := schema.GroupKind{Group: config.Group, Kind: config.Kind}
+	mapping, err := r.mgr.GetRESTMapper().RESTMapping(groupKind, config.Version)
+	if err != nil {
+		return nil, kindConditionReasonNotFound, fmt.Errorf("failed to find kind %s in the cluster: %+w",
+			groupKind.WithVersion(config.Version), err)
+	}
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return nil, kindConditionReasonInvalid, fmt.Errorf("cluster scoped kind %s cannot be replicated", config.Kind)
+	}
+	return config.NewReplicator(), "", nil
+}
+
+// findKindConflict checks whether the kind is already replicated by other controllers
+func (r *ReplicatedKindReconciler) findKindConflict(replicatedKind *v1alpha1.ReplicatedKind) (string, error) {
+	kind := replicatedKind.Spec.Kind
+	if findReplicator(r.Replicators, kind) != nil {
+		return kindConditionReasonConflict, fmt.Errorf("kind %s is already replicated by the operator", kind)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for name, running := range r.runningKinds {
+		if name != replicatedKind.GetName() && running.replicator.GetKind() == kind {
+			return kindConditionReasonConflict, fmt.Errorf("kind %s is already replicated by replicated kind %s", kind, name)
+		}
+	}
+	return "", nil
+}
+
+// startKind starts the controllers replicating a kind
+func (r *ReplicatedKindReconciler) startKind(ctx context.Context, replicatedKind *v1alpha1.ReplicatedKind,
+	replicator replication.Replicator) error {
+	r.lock.Lock()
+	baseCtx := r.ctx
+	r.lock.Unlock()
+	if baseCtx == nil {
+		return fmt.Errorf("controllers cannot be started before the manager is started")
+	}
+
+	// Reading objects of a kind without an informer fails (the unstructured objects of the kinds added at
+	// runtime are read from the cache as well), and hence the informer is started along with the indexes
+	// of the kind before any controller is allowed to read objects of the kind
+	isStarted := false
+	defer func() {
+		if !isStarted {
+			if err := r.removeInformer(ctx, replicator); err != nil {
+				log.FromContext(ctx).Error(err, "Failed to remove informer of replicated kind")
+			}
+		}
+	}()
+	syncCtx, cancelSync := context.WithTimeout(ctx, kindInformerSyncTimeout)
+	defer cancelSync()
+	// The informer of the kind is removed when the kind is stopped, and hence the indexes are added again
+	if err := setupFieldIndexes(syncCtx, r.mgr.GetFieldIndexer(), replicator.EmptyObject()); err != nil {
+		return fmt.Errorf("failed to index objects of kind %s: %+w", replicator.GetKind(), err)
+	}
+	_, err := r.mgr.GetCache().GetInformer(syncCtx, replicator.EmptyObject())
+	if err != nil {
+		return fmt.Errorf("failed to start informer of kind %s: %+w", replicator.GetKind(), err)
+	}
+
+	controllers, err := (&ReplicationReconciler{
+		Replicator:     replicator,
+		RemoteClusters: r.RemoteClusters,
+	}).NewUnmanagedControllers(r.mgr)
+	if err != nil {
+		return err
+	}
+	if r.PolicyReconciler != nil {
+		policyController, err := r.PolicyReconciler.NewUnmanagedSourceController(r.mgr, replicator)
+		if err != nil {
+			return err
+		}
+		controllers = append(controllers, policyController)
+	}
+
+	kindCtx, cancel := context.WithCancel(baseCtx)
+	kindCtx = log.IntoContext(kindCtx, log.FromContext(ctx))
+	running := &runningKind{
+		generation: replicatedKind.GetGeneration(),
+		replicator: replicator,
+		cancel:     cancel,
+	}
+	for _, c := range controllers {
+		running.done.Add(1)
+		go func() {
+			defer running.done.Done()
+			if err := c.Start(kindCtx); err != nil {
+				log.FromContext(kindCtx).Error(err, "Failed to run controller of replicated kind")
+			}
+		}()
+	}
+
+	isStarted = true
+	r.lock.Lock()
+	r.runningKinds[replicatedKind.GetName()] = running
+	r.lock.Unlock()
+	r.RegisteredReplicators.Add(replicator)
+	log.FromContext(ctx).V(1).Info("Started controllers of replicated kind", "objectKind", replicator.GetKind())
+	return nil
+}
+
+// stopKind stops the controllers replicating a kind (if running)
+func (r *ReplicatedKindReconciler) stopKind(ctx context.Context, name string) error {
+	r.lock.Lock()
+	running, ok := r.runningKinds[name]
+	delete(r.runningKinds, name)
+	r.lock.Unlock()
+	if !ok {
+		return nil
+	}
+
+	r.RegisteredReplicators.Remove(running.replicator.GetKind())
+	running.cancel()
+	running.done.Wait()
+	log.FromContext(ctx).V(1).Info("Stopped controllers of replicated kind", "objectKind", running.replicator.GetKind())
+	return r.removeInformer(ctx, running.replicator)
+}
+
+func (r *ReplicatedKindReconciler) removeInformer(ctx context.Context, replicator replication.Replicator) error {
+	err := r.mgr.GetCache().RemoveInformer(ctx, replicator.EmptyObject())
+	if err != nil {
+		return fmt.Errorf("failed to remove informer of kind %s: %+w", replicator.GetKind(), err)
+	}
+	return nil
+}
+
+func (r *ReplicatedKindReconciler) isRunning(name string, generation int64) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	running, ok := r.runningKinds[name]
+	return ok && running.generation == generation
+}
+
+// releaseObjects removes the finalizers added by the replicator from the objects of a kind which is no longer
+// replicated. The objects are read directly from the API server as the informer of the kind is already removed.
+func (r *ReplicatedKindReconciler) releaseObjects(ctx context.Context, replicator replication.Replicator) error {
+	objectList := replicator.EmptyObjectList()
+	err := r.apiReader.List(ctx, objectList)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			// Kind removed from the cluster along with its objects
+			return nil
+		}
+		return fmt.Errorf("failed to list objects of kind %s: %+w", replicator.GetKind(), err)
+	}
+
+	errs := []error{}
+	for _, object := range replicator.ObjectListToArray(objectList) {
+		if err := removeFinalizer(ctx, r.Client, object); err != nil {
+			errs = append(errs, fmt.Errorf("failed to release object %s/%s: %+w", object.GetNamespace(), object.GetName(), err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to release objects of kind %s: %+v", replicator.GetKind(), errs)
+	}
+	return nil
+}
+
+func (r *ReplicatedKindReconciler) updateReadyCondition(ctx context.Context, replicatedKind *v1alpha1.ReplicatedKind,
+	status metav1.ConditionStatus, reason string, message string) error {
+	replicatedKind.Status.ObservedGeneration = replicatedKind.GetGeneration()
+	meta.SetStatusCondition(&replicatedKind.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ReplicatedKindConditionReady,
+		Status:             status,
+		ObservedGeneration: replicatedKind.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.Status().Update(ctx, replicatedKind); err != nil {
+		return fmt.Errorf("failed to update replicated kind status: %+w", err)
+	}
+	return nil
+}
+
+// run binds the lifetime of the controllers started for the replicated kinds to the lifetime of the manager
+func (r *ReplicatedKindReconciler) run(ctx context.Context) error {
+	r.lock.Lock()
+	r.ctx = ctx
+	r.lock.Unlock()
+
+	<-ctx.Done()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for name, running := range r.runningKinds {
+		running.done.Wait()
+		delete(r.runningKinds, name)
+	}
+	return nil
+}
+
+func newReplicatorConfig(replicatedKind *v1alpha1.ReplicatedKind) replication.UnstructuredReplicatorConfig {
+	return replication.UnstructuredReplicatorConfig{
+		Group:   replicatedKind.Spec.Group,
+		Version: replicatedKind.Spec.Version,
+		Kind:    replicatedKind.Spec.Kind,
+		Fields:  replicatedKind.Spec.Fields,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReplicatedKindReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	name := "replicator-replicatedkind-controller"
+	r.recorder = mgr.GetEventRecorderFor(name)
+	r.apiReader = mgr.GetAPIReader()
+	r.mgr = mgr
+	r.runningKinds = map[string]*runningKind{}
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Scheme == nil {
+		r.Scheme = mgr.GetScheme()
+	}
+	if r.RegisteredReplicators == nil {
+		r.RegisteredReplicators = NewReplicatorRegistry()
+	}
+
+	if err := mgr.Add(manager.RunnableFunc(r.run)); err != nil {
+		return fmt.Errorf("failed to add replicated kind controllers runnable: %+w", err)
+	}
+	options := newManagerOptions(mgr, name, "ReplicatedKind")
+	options.MaxConcurrentReconciles = 1 // Kinds are started one at a time for detecting conflicting replicated kinds
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ReplicatedKind{}).
+		WithOptions(options).
+		Complete(newTracingReconciler(name, r))
+}
@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("Replicated Kind", func() {
+	var sourceNamespace *corev1.Namespace
+	var replicatedKind *v1alpha1.ReplicatedKind
+	nc := namespaceCreator{}
+
+	BeforeEach(func(ctx SpecContext) {
+		sourceNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-ns-" + uuid.New().String(),
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+
+		replicatedKind = &v1alpha1.ReplicatedKind{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-kind-" + uuid.New().String(),
+			},
+			Spec: v1alpha1.ReplicatedKindSpec{
+				Version: "v1",
+				Kind:    "PodTemplate",
+				Fields:  []string{"template"},
+			},
+		}
+	})
+
+	AfterEach(func(ctx SpecContext) {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, replicatedKind))).To(Succeed())
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(replicatedKind), &v1alpha1.ReplicatedKind{})
+			return client.IgnoreNotFound(err) == nil && err != nil
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		replicatedKind = nil
+
+		deleteNamespace(ctx, sourceNamespace)
+		sourceNamespace = nil
+
+		nc.Cleanup(ctx)
+	})
+
+	It("Should replicate objects of the kind once created", func(ctx SpecContext) {
+		Expect(k8sClient.Create(ctx, replicatedKind)).To(Succeed())
+		validateReplicatedKindCondition(ctx, replicatedKind, metav1.ConditionTrue, kindConditionReasonStarted)
+
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		sourceObject := &corev1.PodTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-pod-template",
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "test-container",
+							Image: "busybox",
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+		for _, ns := range targetNamespaces {
+			Eventually(func() bool {
+				replica := &corev1.PodTemplate{}
+				err := k8sClient.Get(ctx, replicaKey(sourceObject, ns), replica)
+				if err != nil {
+					return false
+				}
+				return replica.GetLabels()[objectTypeLabelKey] == objectTypeLabelValueReplica &&
+					equality.Semantic.DeepEqual(replica.Template.Spec.Containers, sourceObject.Template.Spec.Containers)
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+
+		By("deleting the replicated kind")
+		Expect(k8sClient.Delete(ctx, replicatedKind)).To(Succeed())
+		Eventually(func() bool {
+			latestSourceObject := &corev1.PodTemplate{}
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject)
+			return err == nil && !controllerutil.ContainsFinalizer(latestSourceObject, resourceFinalizer)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+	}, testTimeout)
+
+	It("Should remove the replicas of the deleted sources of the kind", func(ctx SpecContext) {
+		Expect(k8sClient.Create(ctx, replicatedKind)).To(Succeed())
+		validateReplicatedKindCondition(ctx, replicatedKind, metav1.ConditionTrue, kindConditionReasonStarted)
+
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+		sourceObject := &corev1.PodTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-pod-template",
+				Namespace: sourceNamespace.GetName(),
+				Labels: map[string]string{
+					objectTypeLabelKey: objectTypeLabelValueReplicated,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "test-container",
+							Image: "busybox",
+						},
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+		for _, ns := range targetNamespaces {
+			Eventually(func() error {
+				return k8sClient.Get(ctx, replicaKey(sourceObject, ns), &corev1.PodTemplate{})
+			}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		}
+
+		By("creating a namespace after the source object")
+		newNamespaces := nc.CreateNamespaces(ctx, "test-new-ns", 1, nil)
+		Eventually(func() error {
+			return k8sClient.Get(ctx, replicaKey(sourceObject, newNamespaces[0]), &corev1.PodTemplate{})
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+		targetNamespaces = append(targetNamespaces, newNamespaces...)
+
+		By("deleting the source object")
+		Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+		for _, ns := range targetNamespaces {
+			Eventually(func() bool {
+				err := k8sClient.Get(ctx, replicaKey(sourceObject, ns), &corev1.PodTemplate{})
+				return errors.IsNotFound(err)
+			}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+		}
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), &corev1.PodTemplate{})
+			return errors.IsNotFound(err)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+	}, testTimeout)
+
+	It("Should not replicate kinds which are already replicated", func(ctx SpecContext) {
+		replicatedKind.Spec.Kind = "Secret"
+		replicatedKind.Spec.Fields = []string{"data"}
+		Expect(k8sClient.Create(ctx, replicatedKind)).To(Succeed())
+
+		validateReplicatedKindCondition(ctx, replicatedKind, metav1.ConditionFalse, kindConditionReasonConflict)
+	}, testTimeout)
+
+	It("Should not replicate kinds missing in the cluster", func(ctx SpecContext) {
+		replicatedKind.Spec.Group = "example.com"
+		replicatedKind.Spec.Kind = "Unknown"
+		Expect(k8sClient.Create(ctx, replicatedKind)).To(Succeed())
+
+		validateReplicatedKindCondition(ctx, replicatedKind, metav1.ConditionFalse, kindConditionReasonNotFound)
+	}, testTimeout)
+})
+
+func validateReplicatedKindCondition(ctx context.Context, replicatedKind *v1alpha1.ReplicatedKind,
+	status metav1.ConditionStatus, reason string) {
+	Eventually(func() bool {
+		latestReplicatedKind := &v1alpha1.ReplicatedKind{}
+		err := k8sClient.Get(ctx, client.ObjectKeyFromObject(replicatedKind), latestReplicatedKind)
+		if err != nil {
+			return false
+		}
+		condition := meta.FindStatusCondition(latestReplicatedKind.Status.Conditions, v1alpha1.ReplicatedKindConditionReady)
+		return condition != nil && condition.Status == status && condition.Reason == reason
+	}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+//+kubebuilder:rbac:groups="projectcalico.org",resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="projectcalico.org",resources=networkpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="projectcalico.org",resources=networkpolicies/finalizers,verbs=update
+
+var calicoNetworkPolicyGVK = schema.GroupVersionKind{
+	Group:   "projectcalico.org",
+	Version: "v3",
+	Kind:    "NetworkPolicy",
+}
+
+// newCalicoNetworkPolicyReplicator creates a replicator for Calico network policies. The Calico types are not
+// imported to avoid depending on Calico, and hence the policies are replicated as unstructured objects.
+func newCalicoNetworkPolicyReplicator() Replicator {
+	return &calicoNetworkPolicyReplicator{
+		Replicator: NewUnstructuredReplicator(calicoNetworkPolicyGVK, []string{"spec"}),
+	}
+}
+
+type calicoNetworkPolicyReplicator struct {
+	Replicator
+}
+
+// GetKind qualifies the kind using the API group, as Calico network policies share the kind with
+// the Kubernetes network policies
+func (r *calicoNetworkPolicyReplicator) GetKind() string {
+	return calicoNetworkPolicyGVK.GroupKind().String()
+}
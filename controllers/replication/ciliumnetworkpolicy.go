@@ -0,0 +1,35 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+//+kubebuilder:rbac:groups="cilium.io",resources=ciliumnetworkpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="cilium.io",resources=ciliumnetworkpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="cilium.io",resources=ciliumnetworkpolicies/finalizers,verbs=update
+
+var ciliumNetworkPolicyGVK = schema.GroupVersionKind{
+	Group:   "cilium.io",
+	Version: "v2",
+	Kind:    "CiliumNetworkPolicy",
+}
+
+// newCiliumNetworkPolicyReplicator creates a replicator for Cilium network policies. The Cilium types are not
+// imported to avoid depending on Cilium, and hence the policies are replicated as unstructured objects.
+func newCiliumNetworkPolicyReplicator() Replicator {
+	// A policy contains either a single rule (spec) or a list of rules (specs)
+	return NewUnstructuredReplicator(ciliumNetworkPolicyGVK, []string{"spec", "specs"})
+}
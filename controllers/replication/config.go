@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// reservedFields are the top-level fields which are managed by the API server or the replicator
+var reservedFields = map[string]bool{
+	"apiVersion": true,
+	"kind":       true,
+	"metadata":   true,
+	"status":     true,
+}
+
+// ReplicatorsConfig lists the additional resource kinds replicated using unstructured replicators
+type ReplicatorsConfig struct {
+	Replicators []UnstructuredReplicatorConfig `json:"replicators"`
+}
+
+// UnstructuredReplicatorConfig configures the replication of a resource kind
+type UnstructuredReplicatorConfig struct {
+	// Group of the resource kind (empty for the core API group)
+	Group string `json:"group,omitempty"`
+	// Version of the resource kind
+	Version string `json:"version"`
+	// Kind of the resource
+	Kind string `json:"kind"`
+	// Fields are the top-level fields copied from the source objects (e.g. spec, data)
+	Fields []string `json:"fields"`
+}
+
+// LoadReplicators reads a replicators config file and creates the configured replicators. The kinds
+// are required to be unique among the configured and the built-in replicators.
+func LoadReplicators(configFile string, builtInReplicators []Replicator) ([]Replicator, error) {
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replicators config file: %+w", err)
+	}
+	config := &ReplicatorsConfig{}
+	if err := yaml.UnmarshalStrict(content, config); err != nil {
+		return nil, fmt.Errorf("failed to parse replicators config file: %+w", err)
+	}
+
+	kinds := map[string]bool{}
+	for _, replicator := range builtInReplicators {
+		kinds[replicator.GetKind()] = true
+	}
+	replicators := []Replicator{}
+	for i, replicatorConfig := range config.Replicators {
+		if err := replicatorConfig.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid replicator %d: %+w", i, err)
+		}
+		if kinds[replicatorConfig.Kind] {
+			return nil, fmt.Errorf("kind %s in replicator %d is already replicated", replicatorConfig.Kind, i)
+		}
+
+		kinds[replicatorConfig.Kind] = true
+		replicators = append(replicators, replicatorConfig.NewReplicator())
+	}
+	return replicators, nil
+}
+
+// Validate checks whether the config describes a replicable resource kind
+func (c UnstructuredReplicatorConfig) Validate() error {
+	if c.Version == "" || c.Kind == "" {
+		return fmt.Errorf("version and kind are required")
+	}
+	if len(c.Fields) == 0 {
+		return fmt.Errorf("at least one field is required")
+	}
+	for _, field := range c.Fields {
+		if reservedFields[field] {
+			return fmt.Errorf("field %s cannot be replicated", field)
+		}
+	}
+	return nil
+}
+
+// NewReplicator creates the unstructured replicator described by the config
+func (c UnstructuredReplicatorConfig) NewReplicator() Replicator {
+	gvk := schema.GroupVersionKind{
+		Group:   c.Group,
+		Version: c.Version,
+		Kind:    c.Kind,
+	}
+	return NewUnstructuredReplicator(gvk, c.Fields)
+}
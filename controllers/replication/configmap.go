@@ -55,11 +55,15 @@ func (r *configMapReplicator) ObjectListToArray(list client.ObjectList) []client
 }
 
 func (r *configMapReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	r.ReplicateFiltered(sourceObject, targetObject, nil)
+}
+
+func (r *configMapReplicator) ReplicateFiltered(sourceObject client.Object, targetObject client.Object, filter KeyFilter) {
 	sourceConfigMap := sourceObject.(*corev1.ConfigMap)
 	targetConfigMap := targetObject.(*corev1.ConfigMap)
 
 	// Copy ConfigMap-specific fields
 	targetConfigMap.Immutable = sourceConfigMap.Immutable
-	targetConfigMap.Data = sourceConfigMap.Data
-	targetConfigMap.BinaryData = sourceConfigMap.BinaryData
+	targetConfigMap.Data = filterKeys(sourceConfigMap.Data, filter)
+	targetConfigMap.BinaryData = filterKeys(sourceConfigMap.BinaryData, filter)
 }
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups="autoscaling",resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="autoscaling",resources=horizontalpodautoscalers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="autoscaling",resources=horizontalpodautoscalers/finalizers,verbs=update
+
+func newHorizontalPodAutoscalerReplicator() *horizontalPodAutoscalerReplicator {
+	return &horizontalPodAutoscalerReplicator{}
+}
+
+type horizontalPodAutoscalerReplicator struct{}
+
+func (r *horizontalPodAutoscalerReplicator) GetKind() string {
+	return "HorizontalPodAutoscaler"
+}
+
+func (r *horizontalPodAutoscalerReplicator) AddToScheme(scheme *runtime.Scheme) error {
+	return autoscalingv2.AddToScheme(scheme)
+}
+
+func (r *horizontalPodAutoscalerReplicator) EmptyObject() client.Object {
+	return &autoscalingv2.HorizontalPodAutoscaler{}
+}
+
+func (r *horizontalPodAutoscalerReplicator) EmptyObjectList() client.ObjectList {
+	return &autoscalingv2.HorizontalPodAutoscalerList{}
+}
+
+func (r *horizontalPodAutoscalerReplicator) ObjectListToArray(list client.ObjectList) []client.Object {
+	horizontalPodAutoscalers := list.(*autoscalingv2.HorizontalPodAutoscalerList).Items
+	array := make([]client.Object, len(horizontalPodAutoscalers))
+	for i := range horizontalPodAutoscalers {
+		array[i] = &horizontalPodAutoscalers[i]
+	}
+	return array
+}
+
+func (r *horizontalPodAutoscalerReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	sourceHorizontalPodAutoscaler := sourceObject.(*autoscalingv2.HorizontalPodAutoscaler)
+	targetHorizontalPodAutoscaler := targetObject.(*autoscalingv2.HorizontalPodAutoscaler)
+
+	// Copy HorizontalPodAutoscaler-specific fields (the status is maintained by the autoscaler of the target namespace)
+	sourceHorizontalPodAutoscaler.Spec.DeepCopyInto(&targetHorizontalPodAutoscaler.Spec)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"fmt"
+	"path"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KeyFilter decides whether a data key of a source object is replicated. A nil KeyFilter replicates
+// all the keys.
+type KeyFilter func(key string) bool
+
+// KeyFilteringReplicator is implemented by replicators of resources holding keyed data (such as
+// Secrets and ConfigMaps), which support replicating only a subset of the keys.
+type KeyFilteringReplicator interface {
+	Replicator
+
+	// ReplicateFiltered copies the data from the source object to the target object similar to
+	// Replicate, but only copies the data keys accepted by the filter. Keys which are not accepted
+	// are removed from the target object. The source object is not modified.
+	ReplicateFiltered(sourceObject client.Object, targetObject client.Object, filter KeyFilter)
+}
+
+// NewKeyFilter creates a filter accepting the keys matching any of the include patterns (or all the
+// keys if none are provided) and none of the exclude patterns. Patterns are exact keys or globs.
+func NewKeyFilter(includePatterns []string, excludePatterns []string) (KeyFilter, error) {
+	for _, pattern := range append(append([]string{}, includePatterns...), excludePatterns...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid key pattern %q: %+w", pattern, err)
+		}
+	}
+	return func(key string) bool {
+		if matchesAnyKeyPattern(excludePatterns, key) {
+			return false
+		}
+		return len(includePatterns) == 0 || matchesAnyKeyPattern(includePatterns, key)
+	}, nil
+}
+
+// And returns a filter accepting the keys accepted by both the filters
+func (f KeyFilter) And(other KeyFilter) KeyFilter {
+	if f == nil {
+		return other
+	}
+	if other == nil {
+		return f
+	}
+	return func(key string) bool {
+		return f(key) && other(key)
+	}
+}
+
+func matchesAnyKeyPattern(patterns []string, key string) bool {
+	for _, pattern := range patterns {
+		// Patterns are validated when the filter is created
+		if isMatch, _ := path.Match(pattern, key); isMatch {
+			return true
+		}
+	}
+	return false
+}
+
+// filterKeys returns a copy of the data map with only the keys accepted by the filter
+func filterKeys[V any](data map[string]V, filter KeyFilter) map[string]V {
+	if data == nil {
+		return nil
+	}
+	if filter == nil {
+		return data
+	}
+	filtered := map[string]V{}
+	for key, value := range data {
+		if filter(key) {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Key Filtering", func() {
+	Context("When replicating a TLS secret", func() {
+		sourceSecret := &corev1.Secret{
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				"ca.crt":  []byte("ca"),
+				"tls.crt": []byte("cert"),
+				"tls.key": []byte("key"),
+			},
+		}
+
+		It("Should replicate only the included keys", func() {
+			filter, err := NewKeyFilter([]string{"ca.crt"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			targetSecret := &corev1.Secret{}
+			newSecretReplicator().ReplicateFiltered(sourceSecret, targetSecret, filter)
+
+			Expect(targetSecret.Data).To(HaveLen(1))
+			Expect(targetSecret.Data).To(HaveKey("ca.crt"))
+			Expect(targetSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(sourceSecret.Data).To(HaveLen(3))
+		})
+
+		It("Should remove excluded keys from existing replicas", func() {
+			filter, err := NewKeyFilter(nil, []string{"*.key"})
+			Expect(err).NotTo(HaveOccurred())
+
+			targetSecret := sourceSecret.DeepCopy()
+			newSecretReplicator().ReplicateFiltered(sourceSecret, targetSecret, filter)
+
+			Expect(targetSecret.Data).To(HaveLen(2))
+			Expect(targetSecret.Data).NotTo(HaveKey("tls.key"))
+			Expect(sourceSecret.Data).To(HaveKey("tls.key"))
+		})
+
+		It("Should recreate existing replicas of which the secret type changes", func() {
+			filter, err := NewKeyFilter(nil, []string{"*.key"})
+			Expect(err).NotTo(HaveOccurred())
+
+			existingSecret := sourceSecret.DeepCopy()
+			targetSecret := existingSecret.DeepCopy()
+			replicator := newSecretReplicator()
+			replicator.ReplicateFiltered(sourceSecret, targetSecret, filter)
+
+			Expect(targetSecret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(replicator.RequiresRecreation(existingSecret, targetSecret)).To(BeTrue())
+			Expect(existingSecret.Type).To(Equal(corev1.SecretTypeTLS))
+		})
+
+		It("Should update existing replicas of which the secret type does not change", func() {
+			filter, err := NewKeyFilter(nil, []string{"ca.crt"})
+			Expect(err).NotTo(HaveOccurred())
+
+			existingSecret := sourceSecret.DeepCopy()
+			targetSecret := existingSecret.DeepCopy()
+			replicator := newSecretReplicator()
+			replicator.ReplicateFiltered(sourceSecret, targetSecret, filter)
+
+			Expect(targetSecret.Data).NotTo(HaveKey("ca.crt"))
+			Expect(replicator.RequiresRecreation(existingSecret, targetSecret)).To(BeFalse())
+		})
+
+		It("Should keep the secret type if the required keys are replicated", func() {
+			filter, err := NewKeyFilter([]string{"tls.*"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			targetSecret := &corev1.Secret{}
+			newSecretReplicator().ReplicateFiltered(sourceSecret, targetSecret, filter)
+
+			Expect(targetSecret.Data).To(HaveLen(2))
+			Expect(targetSecret.Type).To(Equal(corev1.SecretTypeTLS))
+		})
+	})
+
+	Context("When replicating a config map", func() {
+		It("Should apply both include and exclude patterns", func() {
+			sourceConfigMap := &corev1.ConfigMap{
+				Data: map[string]string{
+					"app.properties":     "a",
+					"app.local.yaml":     "b",
+					"logging.properties": "c",
+				},
+				BinaryData: map[string][]byte{
+					"app.bin": []byte("d"),
+				},
+			}
+			filter, err := NewKeyFilter([]string{"app.*"}, []string{"*.yaml"})
+			Expect(err).NotTo(HaveOccurred())
+
+			targetConfigMap := &corev1.ConfigMap{}
+			newConfigMapReplicator().ReplicateFiltered(sourceConfigMap, targetConfigMap, filter)
+
+			Expect(targetConfigMap.Data).To(HaveLen(1))
+			Expect(targetConfigMap.Data).To(HaveKey("app.properties"))
+			Expect(targetConfigMap.BinaryData).To(HaveKey("app.bin"))
+		})
+	})
+
+	Context("When creating a key filter", func() {
+		It("Should reject invalid patterns", func() {
+			_, err := NewKeyFilter([]string{"["}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
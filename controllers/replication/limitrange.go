@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups="",resources=limitranges,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=limitranges/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=limitranges/finalizers,verbs=update
+
+func newLimitRangeReplicator() *limitRangeReplicator {
+	return &limitRangeReplicator{}
+}
+
+type limitRangeReplicator struct{}
+
+func (r *limitRangeReplicator) GetKind() string {
+	return "LimitRange"
+}
+
+func (r *limitRangeReplicator) AddToScheme(scheme *runtime.Scheme) error {
+	return corev1.AddToScheme(scheme)
+}
+
+func (r *limitRangeReplicator) EmptyObject() client.Object {
+	return &corev1.LimitRange{}
+}
+
+func (r *limitRangeReplicator) EmptyObjectList() client.ObjectList {
+	return &corev1.LimitRangeList{}
+}
+
+func (r *limitRangeReplicator) ObjectListToArray(list client.ObjectList) []client.Object {
+	limitRanges := list.(*corev1.LimitRangeList).Items
+	array := make([]client.Object, len(limitRanges))
+	for i := range limitRanges {
+		array[i] = &limitRanges[i]
+	}
+	return array
+}
+
+func (r *limitRangeReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	sourceLimitRange := sourceObject.(*corev1.LimitRange)
+	targetLimitRange := targetObject.(*corev1.LimitRange)
+
+	// Copy LimitRange-specific fields
+	sourceLimitRange.Spec.DeepCopyInto(&targetLimitRange.Spec)
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="policy",resources=poddisruptionbudgets/finalizers,verbs=update
+
+func newPodDisruptionBudgetReplicator() *podDisruptionBudgetReplicator {
+	return &podDisruptionBudgetReplicator{}
+}
+
+type podDisruptionBudgetReplicator struct{}
+
+func (r *podDisruptionBudgetReplicator) GetKind() string {
+	return "PodDisruptionBudget"
+}
+
+func (r *podDisruptionBudgetReplicator) AddToScheme(scheme *runtime.Scheme) error {
+	return policyv1.AddToScheme(scheme)
+}
+
+func (r *podDisruptionBudgetReplicator) EmptyObject() client.Object {
+	return &policyv1.PodDisruptionBudget{}
+}
+
+func (r *podDisruptionBudgetReplicator) EmptyObjectList() client.ObjectList {
+	return &policyv1.PodDisruptionBudgetList{}
+}
+
+func (r *podDisruptionBudgetReplicator) ObjectListToArray(list client.ObjectList) []client.Object {
+	podDisruptionBudgets := list.(*policyv1.PodDisruptionBudgetList).Items
+	array := make([]client.Object, len(podDisruptionBudgets))
+	for i := range podDisruptionBudgets {
+		array[i] = &podDisruptionBudgets[i]
+	}
+	return array
+}
+
+func (r *podDisruptionBudgetReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	sourcePodDisruptionBudget := sourceObject.(*policyv1.PodDisruptionBudget)
+	targetPodDisruptionBudget := targetObject.(*policyv1.PodDisruptionBudget)
+
+	// Copy PodDisruptionBudget-specific fields (the status is maintained by the disruption controller of the target namespace)
+	sourcePodDisruptionBudget.Spec.DeepCopyInto(&targetPodDisruptionBudget.Spec)
+}
@@ -21,13 +21,24 @@
 //   - ConfigMaps: Configuration data replication
 //   - NetworkPolicies: Security policy replication
 //   - ServiceAccounts: Service account and RBAC replication
+//   - LimitRanges and ResourceQuotas: Baseline resource constraints replication
+//   - PodDisruptionBudgets and HorizontalPodAutoscalers: Workload availability and scaling defaults replication
+//   - CiliumNetworkPolicies and Calico NetworkPolicies: CNI specific security policy replication
+//     (only if served by the cluster)
 //
 // To add support for a new resource type, implement the Replicator interface
-// and register it in the NewReplicators() function.
+// and register it in the NewReplicators() function. Similarly, replicas can be
+// transformed using the Transformer implementations registered in newTransformers().
 package replication
 
 import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -64,6 +75,17 @@ type Replicator interface {
 	Replicate(sourceObject client.Object, targetObject client.Object)
 }
 
+// RecreatingReplicator is implemented by replicators of resources with fields which cannot be changed
+// once the objects are created (such as the type of Secrets). Replicas which need such fields changed
+// are deleted and created again instead of being updated.
+type RecreatingReplicator interface {
+	Replicator
+
+	// RequiresRecreation checks whether the changes made to an existing replica (the target object)
+	// cannot be applied by updating the replica. Neither of the objects is modified.
+	RequiresRecreation(existingObject client.Object, targetObject client.Object) bool
+}
+
 // NewReplicators returns a slice of all available replicator implementations.
 // This function is used to register all supported resource types with the controller.
 // To add support for a new resource type, implement the Replicator interface and
@@ -76,5 +98,47 @@ func NewReplicators() []Replicator {
 		newServiceAccountReplicator(),
 		newRoleReplicator(),
 		newRoleBindingReplicator(),
+		newLimitRangeReplicator(),
+		newResourceQuotaReplicator(),
+		newPodDisruptionBudgetReplicator(),
+		newHorizontalPodAutoscalerReplicator(),
+	}
+}
+
+// NewOptionalReplicators returns the replicator implementations of the kinds which are not served by
+// every cluster (e.g. the network policies of specific CNIs). The discovery client is used to skip the
+// kinds which are not served by the cluster, so that the controllers are not started for them. The kinds
+// which fail to be discovered are logged and skipped, as they are not required for the replicator to run.
+func NewOptionalReplicators(discoveryClient discovery.DiscoveryInterface, log logr.Logger) []Replicator {
+	replicators := []Replicator{}
+	for _, replicator := range []Replicator{
+		newCiliumNetworkPolicyReplicator(),
+		newCalicoNetworkPolicyReplicator(),
+	} {
+		isServed, err := isKindServed(discoveryClient, replicator.EmptyObject().GetObjectKind().GroupVersionKind())
+		if err != nil {
+			log.Error(err, "skipping optional kind which failed to be discovered", "kind", replicator.GetKind())
+			continue
+		}
+		if isServed {
+			replicators = append(replicators, replicator)
+		}
+	}
+	return replicators
+}
+
+func isKindServed(discoveryClient discovery.DiscoveryInterface, gvk schema.GroupVersionKind) (bool, error) {
+	resources, err := discoveryClient.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to discover resources of %s: %+w", gvk.GroupVersion(), err)
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Kind == gvk.Kind && resource.Namespaced {
+			return true, nil
+		}
 	}
+	return false, nil
 }
@@ -14,11 +14,17 @@
 package replication
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/go-logr/logr"
 	"github.com/nadundesilva/k8s-replicator/test/utils/testdata"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	clienttesting "k8s.io/client-go/testing"
 )
 
 func TestAPIs(t *testing.T) {
@@ -47,3 +53,71 @@ var _ = Describe("Object Replication Test Data", func() {
 	})
 
 })
+
+var _ = Describe("Optional Replicators", func() {
+	newDiscoveryClient := func(resources ...*metav1.APIResourceList) *fakediscovery.FakeDiscovery {
+		return &fakediscovery.FakeDiscovery{
+			Fake: &clienttesting.Fake{
+				Resources: resources,
+			},
+		}
+	}
+
+	It("Should skip kinds not served by the cluster", func() {
+		replicators := NewOptionalReplicators(newDiscoveryClient(), logr.Discard())
+		Expect(replicators).To(BeEmpty())
+	})
+
+	It("Should include kinds served by the cluster", func() {
+		replicators := NewOptionalReplicators(newDiscoveryClient(
+			&metav1.APIResourceList{
+				GroupVersion: "cilium.io/v2",
+				APIResources: []metav1.APIResource{
+					{Name: "ciliumnetworkpolicies", Kind: "CiliumNetworkPolicy", Namespaced: true},
+					{Name: "ciliumclusterwidenetworkpolicies", Kind: "CiliumClusterwideNetworkPolicy"},
+				},
+			},
+			&metav1.APIResourceList{
+				GroupVersion: "projectcalico.org/v3",
+				APIResources: []metav1.APIResource{
+					{Name: "networkpolicies", Kind: "NetworkPolicy", Namespaced: true},
+				},
+			},
+		), logr.Discard())
+
+		kinds := []string{}
+		for _, replicator := range replicators {
+			kinds = append(kinds, replicator.GetKind())
+		}
+		Expect(kinds).To(HaveExactElements("CiliumNetworkPolicy", "NetworkPolicy.projectcalico.org"))
+	})
+
+	It("Should skip kinds missing in a served group version", func() {
+		replicators := NewOptionalReplicators(newDiscoveryClient(
+			&metav1.APIResourceList{
+				GroupVersion: "cilium.io/v2",
+				APIResources: []metav1.APIResource{
+					{Name: "ciliumendpoints", Kind: "CiliumEndpoint", Namespaced: true},
+				},
+			},
+		), logr.Discard())
+		Expect(replicators).To(BeEmpty())
+	})
+
+	It("Should skip kinds failing to be discovered", func() {
+		discoveryClient := newDiscoveryClient(
+			&metav1.APIResourceList{
+				GroupVersion: "cilium.io/v2",
+				APIResources: []metav1.APIResource{
+					{Name: "ciliumnetworkpolicies", Kind: "CiliumNetworkPolicy", Namespaced: true},
+				},
+			},
+		)
+		discoveryClient.AddReactor("get", "resource", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("discovery is unavailable")
+		})
+
+		replicators := NewOptionalReplicators(discoveryClient, logr.Discard())
+		Expect(replicators).To(BeEmpty())
+	})
+})
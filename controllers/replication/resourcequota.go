@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+//+kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=resourcequotas/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=resourcequotas/finalizers,verbs=update
+
+func newResourceQuotaReplicator() *resourceQuotaReplicator {
+	return &resourceQuotaReplicator{}
+}
+
+type resourceQuotaReplicator struct{}
+
+func (r *resourceQuotaReplicator) GetKind() string {
+	return "ResourceQuota"
+}
+
+func (r *resourceQuotaReplicator) AddToScheme(scheme *runtime.Scheme) error {
+	return corev1.AddToScheme(scheme)
+}
+
+func (r *resourceQuotaReplicator) EmptyObject() client.Object {
+	return &corev1.ResourceQuota{}
+}
+
+func (r *resourceQuotaReplicator) EmptyObjectList() client.ObjectList {
+	return &corev1.ResourceQuotaList{}
+}
+
+func (r *resourceQuotaReplicator) ObjectListToArray(list client.ObjectList) []client.Object {
+	resourceQuotas := list.(*corev1.ResourceQuotaList).Items
+	array := make([]client.Object, len(resourceQuotas))
+	for i := range resourceQuotas {
+		array[i] = &resourceQuotas[i]
+	}
+	return array
+}
+
+func (r *resourceQuotaReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	sourceResourceQuota := sourceObject.(*corev1.ResourceQuota)
+	targetResourceQuota := targetObject.(*corev1.ResourceQuota)
+
+	// Copy ResourceQuota-specific fields (the status is maintained by the quota controller of the target namespace)
+	sourceResourceQuota.Spec.DeepCopyInto(&targetResourceQuota.Spec)
+}
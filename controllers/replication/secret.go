@@ -15,7 +15,9 @@ package replication
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -23,6 +25,14 @@ import (
 //+kubebuilder:rbac:groups="",resources=secrets/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups="",resources=secrets/finalizers,verbs=update
 
+// secretTypeRequiredKeys lists the keys which are required to be present in secrets of each type
+var secretTypeRequiredKeys = map[corev1.SecretType][]string{
+	corev1.SecretTypeTLS:              {corev1.TLSCertKey, corev1.TLSPrivateKeyKey},
+	corev1.SecretTypeDockerConfigJson: {corev1.DockerConfigJsonKey},
+	corev1.SecretTypeDockercfg:        {corev1.DockerConfigKey},
+	corev1.SecretTypeSSHAuth:          {corev1.SSHAuthPrivateKey},
+}
+
 func newSecretReplicator() *secretReplicator {
 	return &secretReplicator{}
 }
@@ -55,12 +65,37 @@ func (r *secretReplicator) ObjectListToArray(list client.ObjectList) []client.Ob
 }
 
 func (r *secretReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	r.ReplicateFiltered(sourceObject, targetObject, nil)
+}
+
+func (r *secretReplicator) ReplicateFiltered(sourceObject client.Object, targetObject client.Object, filter KeyFilter) {
 	sourceSecret := sourceObject.(*corev1.Secret)
 	targetSecret := targetObject.(*corev1.Secret)
 
 	// Copy Secret-specific fields
 	targetSecret.Immutable = sourceSecret.Immutable
-	targetSecret.Data = sourceSecret.Data
-	targetSecret.StringData = sourceSecret.StringData
+	targetSecret.Data = filterKeys(sourceSecret.Data, filter)
+	targetSecret.StringData = filterKeys(sourceSecret.StringData, filter)
 	targetSecret.Type = sourceSecret.Type
+
+	// Secrets missing the keys required by their type are not accepted by the API server
+	for _, requiredKey := range secretTypeRequiredKeys[sourceSecret.Type] {
+		if _, ok := targetSecret.Data[requiredKey]; !ok {
+			targetSecret.Type = corev1.SecretTypeOpaque
+			break
+		}
+	}
+}
+
+func (r *secretReplicator) RequiresRecreation(existingObject client.Object, targetObject client.Object) bool {
+	existingSecret := existingObject.(*corev1.Secret)
+	targetSecret := targetObject.(*corev1.Secret)
+
+	// The type of secrets is immutable, and the type is changed when the required keys are filtered out
+	if existingSecret.Type != targetSecret.Type {
+		return true
+	}
+	// The data of immutable secrets cannot be changed either
+	return ptr.Deref(existingSecret.Immutable, false) &&
+		(!ptr.Deref(targetSecret.Immutable, false) || !equality.Semantic.DeepEqual(existingSecret.Data, targetSecret.Data))
 }
@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Transformer defines the interface for transforming replicas after the source object is replicated
+// into them. Transforms are applied after Replicator.Replicate and before the replica is persisted.
+// New transforms are added by implementing this interface and registering it in newTransformers()
+// (or using RegisterTransformer) without modifying the controllers.
+type Transformer interface {
+	// GetType returns the type used for referring to the transform in transform specifications.
+	GetType() string
+
+	// IsSupported checks whether the transform can be applied to the replicas of the kind of an object.
+	// This is checked along with the configuration before applying the transform to any replica.
+	IsSupported(object client.Object) bool
+
+	// Validate checks whether the configuration of a transform is valid. This is called before
+	// applying the transform to any replica.
+	Validate(config map[string]string) error
+
+	// Transform modifies the target object in-place. Similar to Replicator.Replicate, the target
+	// object is persisted by the controller after all the transforms are applied.
+	Transform(ctx context.Context, transformCtx TransformContext, config map[string]string, targetObject client.Object) error
+}
+
+// TransformContext provides the information available to transforms
+type TransformContext struct {
+	// Reader reads other objects from the cluster the source object is in (such as the sources of merged keys)
+	Reader client.Reader
+	// TargetReader reads other objects from the cluster the replica is created in, which is a remote
+	// cluster for the replicas in remote clusters
+	TargetReader client.Reader
+	// SourceObject is the object being replicated
+	SourceObject client.Object
+	// TargetNamespace is the namespace the replica is created in
+	TargetNamespace *corev1.Namespace
+}
+
+// TransformSpec specifies a transform and its configuration
+type TransformSpec struct {
+	// Type of the transform
+	Type string `json:"type"`
+	// Config is the configuration of the transform
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// TransformPipeline is a validated list of transforms applied in order
+type TransformPipeline []TransformSpec
+
+var (
+	transformersLock sync.RWMutex
+	transformers     = map[string]Transformer{}
+)
+
+func init() {
+	for _, transformer := range newTransformers() {
+		RegisterTransformer(transformer)
+	}
+}
+
+// newTransformers returns all the built-in transform implementations.
+func newTransformers() []Transformer {
+	return []Transformer{
+		newRenameKeysTransformer(),
+		newTemplateTransformer(),
+		newBase64Transformer(),
+		newMergeKeysTransformer(),
+		newSubjectNamespacesTransformer(),
+		newSecretReferencesTransformer(),
+	}
+}
+
+// RegisterTransformer makes a transform available for use in transform specifications. Registering
+// a transform with the type of an existing transform replaces it.
+func RegisterTransformer(transformer Transformer) {
+	transformersLock.Lock()
+	defer transformersLock.Unlock()
+	transformers[transformer.GetType()] = transformer
+}
+
+// GetTransformer returns the registered transform of a type
+func GetTransformer(transformType string) (Transformer, bool) {
+	transformersLock.RLock()
+	defer transformersLock.RUnlock()
+	transformer, ok := transformers[transformType]
+	return transformer, ok
+}
+
+// NewTransformPipeline validates the transform specifications and creates a pipeline applying them in order
+// to the replicas of the kind of an object
+func NewTransformPipeline(object client.Object, specs []TransformSpec) (TransformPipeline, error) {
+	for i, spec := range specs {
+		transformer, ok := GetTransformer(spec.Type)
+		if !ok {
+			return nil, fmt.Errorf("unknown transform type %q in transform %d", spec.Type, i)
+		}
+		if !transformer.IsSupported(object) {
+			return nil, fmt.Errorf("%s transform %d not supported for %T", spec.Type, i, object)
+		}
+		if err := transformer.Validate(spec.Config); err != nil {
+			return nil, fmt.Errorf("invalid configuration in %s transform %d: %+w", spec.Type, i, err)
+		}
+	}
+	return TransformPipeline(specs), nil
+}
+
+// Apply applies the transforms of the pipeline to the target object in order
+func (p TransformPipeline) Apply(ctx context.Context, transformCtx TransformContext, targetObject client.Object) error {
+	for i, spec := range p {
+		transformer, ok := GetTransformer(spec.Type)
+		if !ok {
+			return fmt.Errorf("unknown transform type %q in transform %d", spec.Type, i)
+		}
+		if err := transformer.Transform(ctx, transformCtx, spec.Config, targetObject); err != nil {
+			return fmt.Errorf("failed to apply %s transform %d: %+w", spec.Type, i, err)
+		}
+	}
+	return nil
+}
+
+// keyedData provides uniform access to the data keys of Secrets and ConfigMaps for transforms
+type keyedData interface {
+	Keys() []string
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+	Remove(key string)
+}
+
+// isKeyedData checks whether the data keys of an object can be accessed as keyed data
+func isKeyedData(object client.Object) bool {
+	switch object.(type) {
+	case *corev1.Secret, *corev1.ConfigMap:
+		return true
+	default:
+		return false
+	}
+}
+
+func newKeyedData(object client.Object) (keyedData, error) {
+	switch typedObject := object.(type) {
+	case *corev1.Secret:
+		return &secretData{secret: typedObject}, nil
+	case *corev1.ConfigMap:
+		return &configMapData{configMap: typedObject}, nil
+	default:
+		return nil, fmt.Errorf("transform not supported for %T", object)
+	}
+}
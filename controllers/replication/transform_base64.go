@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	base64ModeEncode = "encode"
+	base64ModeDecode = "decode"
+)
+
+// base64Transformer re-encodes data values. The "mode" configuration is either "encode" or "decode",
+// and the optional "keys" configuration limits the transformed keys using comma-separated keys or
+// glob patterns.
+type base64Transformer struct{}
+
+func newBase64Transformer() *base64Transformer {
+	return &base64Transformer{}
+}
+
+func (t *base64Transformer) GetType() string {
+	return "base64"
+}
+
+func (t *base64Transformer) IsSupported(object client.Object) bool {
+	return isKeyedData(object)
+}
+
+func (t *base64Transformer) Validate(config map[string]string) error {
+	if mode := config["mode"]; mode != base64ModeEncode && mode != base64ModeDecode {
+		return fmt.Errorf("mode should be either %s or %s, but found %q", base64ModeEncode, base64ModeDecode, mode)
+	}
+	_, err := newConfigKeyFilter(config, "keys")
+	return err
+}
+
+func (t *base64Transformer) Transform(_ context.Context, _ TransformContext, config map[string]string,
+	targetObject client.Object) error {
+	data, err := newKeyedData(targetObject)
+	if err != nil {
+		return err
+	}
+	filter, err := newConfigKeyFilter(config, "keys")
+	if err != nil {
+		return err
+	}
+
+	for _, key := range data.Keys() {
+		if !filter(key) {
+			continue
+		}
+		value, _ := data.Get(key)
+		if config["mode"] == base64ModeEncode {
+			data.Set(key, []byte(base64.StdEncoding.EncodeToString(value)))
+		} else {
+			decoded, err := base64.StdEncoding.DecodeString(string(value))
+			if err != nil {
+				return fmt.Errorf("failed to decode key %s: %+w", key, err)
+			}
+			data.Set(key, decoded)
+		}
+	}
+	return nil
+}
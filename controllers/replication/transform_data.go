@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type secretData struct {
+	secret *corev1.Secret
+}
+
+func (d *secretData) Keys() []string {
+	keys := []string{}
+	for key := range d.secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (d *secretData) Get(key string) ([]byte, bool) {
+	value, ok := d.secret.Data[key]
+	return value, ok
+}
+
+func (d *secretData) Set(key string, value []byte) {
+	if d.secret.Data == nil {
+		d.secret.Data = map[string][]byte{}
+	}
+	d.secret.Data[key] = value
+}
+
+func (d *secretData) Remove(key string) {
+	delete(d.secret.Data, key)
+}
+
+type configMapData struct {
+	configMap *corev1.ConfigMap
+}
+
+func (d *configMapData) Keys() []string {
+	keys := []string{}
+	for key := range d.configMap.Data {
+		keys = append(keys, key)
+	}
+	for key := range d.configMap.BinaryData {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (d *configMapData) Get(key string) ([]byte, bool) {
+	if value, ok := d.configMap.Data[key]; ok {
+		return []byte(value), true
+	}
+	value, ok := d.configMap.BinaryData[key]
+	return value, ok
+}
+
+// Set stores valid UTF-8 values as string data, and all other values as binary data
+func (d *configMapData) Set(key string, value []byte) {
+	d.Remove(key)
+	if utf8.Valid(value) {
+		if d.configMap.Data == nil {
+			d.configMap.Data = map[string]string{}
+		}
+		d.configMap.Data[key] = string(value)
+	} else {
+		if d.configMap.BinaryData == nil {
+			d.configMap.BinaryData = map[string][]byte{}
+		}
+		d.configMap.BinaryData[key] = value
+	}
+}
+
+func (d *configMapData) Remove(key string) {
+	delete(d.configMap.Data, key)
+	delete(d.configMap.BinaryData, key)
+}
+
+// newConfigKeyFilter creates a key filter from the comma-separated key patterns in a transform
+// configuration. All the keys are accepted if no patterns are provided.
+func newConfigKeyFilter(config map[string]string, configKey string) (KeyFilter, error) {
+	patterns := []string{}
+	for _, pattern := range strings.Split(config[configKey], ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return NewKeyFilter(patterns, nil)
+}
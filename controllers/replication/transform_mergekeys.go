@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// mergeKeysTransformer merges the data keys of other objects of the same kind in the namespace of
+// the source object into the replica. The "sources" configuration lists the names of the merged
+// objects (comma-separated), and keys already present in the replica are only replaced if the
+// "overwrite" configuration is "true". The merged objects are not watched, and hence the changes to
+// them are only merged into the replicas when the source object is synced.
+type mergeKeysTransformer struct{}
+
+func newMergeKeysTransformer() *mergeKeysTransformer {
+	return &mergeKeysTransformer{}
+}
+
+func (t *mergeKeysTransformer) GetType() string {
+	return "mergeKeys"
+}
+
+func (t *mergeKeysTransformer) IsSupported(object client.Object) bool {
+	return isKeyedData(object)
+}
+
+func (t *mergeKeysTransformer) Validate(config map[string]string) error {
+	if len(t.getSourceNames(config)) == 0 {
+		return fmt.Errorf("at least one source is required")
+	}
+	if overwrite, ok := config["overwrite"]; ok && overwrite != "true" && overwrite != "false" {
+		return fmt.Errorf("overwrite should be either true or false, but found %q", overwrite)
+	}
+	return nil
+}
+
+func (t *mergeKeysTransformer) Transform(ctx context.Context, transformCtx TransformContext, config map[string]string,
+	targetObject client.Object) error {
+	data, err := newKeyedData(targetObject)
+	if err != nil {
+		return err
+	}
+
+	for _, sourceName := range t.getSourceNames(config) {
+		mergedObject := targetObject.DeepCopyObject().(client.Object)
+		mergedObjectKey := client.ObjectKey{
+			Namespace: transformCtx.SourceObject.GetNamespace(),
+			Name:      sourceName,
+		}
+		if err := transformCtx.Reader.Get(ctx, mergedObjectKey, mergedObject); err != nil {
+			return fmt.Errorf("failed to get merged object %s: %+w", mergedObjectKey, err)
+		}
+		mergedData, err := newKeyedData(mergedObject)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range mergedData.Keys() {
+			if _, exists := data.Get(key); exists && config["overwrite"] != "true" {
+				continue
+			}
+			value, _ := mergedData.Get(key)
+			data.Set(key, value)
+		}
+	}
+	return nil
+}
+
+func (t *mergeKeysTransformer) getSourceNames(config map[string]string) []string {
+	names := []string{}
+	for _, name := range strings.Split(config["sources"], ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// renameKeysTransformer renames data keys. Each configuration entry maps a key to its new name.
+type renameKeysTransformer struct{}
+
+func newRenameKeysTransformer() *renameKeysTransformer {
+	return &renameKeysTransformer{}
+}
+
+func (t *renameKeysTransformer) GetType() string {
+	return "renameKeys"
+}
+
+func (t *renameKeysTransformer) IsSupported(object client.Object) bool {
+	return isKeyedData(object)
+}
+
+func (t *renameKeysTransformer) Validate(config map[string]string) error {
+	if len(config) == 0 {
+		return fmt.Errorf("at least one key to be renamed is required")
+	}
+	for key, newKey := range config {
+		if errs := validation.IsConfigMapKey(newKey); len(errs) > 0 {
+			return fmt.Errorf("invalid new name %q for key %s: %s", newKey, key, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+func (t *renameKeysTransformer) Transform(_ context.Context, _ TransformContext, config map[string]string,
+	targetObject client.Object) error {
+	data, err := newKeyedData(targetObject)
+	if err != nil {
+		return err
+	}
+
+	renamed := map[string][]byte{}
+	for key, newKey := range config {
+		if value, ok := data.Get(key); ok {
+			renamed[newKey] = value
+			data.Remove(key)
+		}
+	}
+	for key, value := range renamed {
+		data.Set(key, value)
+	}
+	return nil
+}
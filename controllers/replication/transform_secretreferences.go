@@ -0,0 +1,81 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package replication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// secretReferencesTransformer drops the Secrets references of ServiceAccount replicas which point to
+// Secrets missing in the target namespace (such as the namespace-local token Secrets of the source
+// ServiceAccount, which are not replicated). References to Secrets in other namespaces are kept.
+type secretReferencesTransformer struct{}
+
+func newSecretReferencesTransformer() *secretReferencesTransformer {
+	return &secretReferencesTransformer{}
+}
+
+func (t *secretReferencesTransformer) GetType() string {
+	return "dropSecretReferences"
+}
+
+func (t *secretReferencesTransformer) IsSupported(object client.Object) bool {
+	_, ok := object.(*corev1.ServiceAccount)
+	return ok
+}
+
+func (t *secretReferencesTransformer) Validate(config map[string]string) error {
+	if len(config) > 0 {
+		return fmt.Errorf("configuration is not supported")
+	}
+	return nil
+}
+
+func (t *secretReferencesTransformer) Transform(ctx context.Context, transformCtx TransformContext,
+	config map[string]string, targetObject client.Object) error {
+	serviceAccount, ok := targetObject.(*corev1.ServiceAccount)
+	if !ok {
+		return fmt.Errorf("transform not supported for %T", targetObject)
+	}
+
+	secrets := []corev1.ObjectReference{}
+	for _, secretRef := range serviceAccount.Secrets {
+		if secretRef.Namespace != "" && secretRef.Namespace != transformCtx.SourceObject.GetNamespace() {
+			secrets = append(secrets, secretRef)
+			continue
+		}
+
+		secretKey := client.ObjectKey{
+			Namespace: transformCtx.TargetNamespace.GetName(),
+			Name:      secretRef.Name,
+		}
+		err := transformCtx.TargetReader.Get(ctx, secretKey, &corev1.Secret{})
+		if err == nil {
+			if secretRef.Namespace != "" {
+				secretRef.Namespace = secretKey.Namespace
+			}
+			secrets = append(secrets, secretRef)
+		} else if client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to get referenced secret %s: %+w", secretKey, err)
+		}
+	}
+	if len(secrets) == 0 {
+		secrets = nil
+	}
+	serviceAccount.Secrets = secrets
+	return nil
+}
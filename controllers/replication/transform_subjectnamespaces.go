@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package replication
+
+import (
+	"context"
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// subjectNamespacesTransformer rewrites the namespace of the ServiceAccount subjects of RoleBinding
+// replicas which refer to the namespace of the source object, so that the replica grants the role to
+// the ServiceAccount of the same name in the target namespace instead.
+type subjectNamespacesTransformer struct{}
+
+func newSubjectNamespacesTransformer() *subjectNamespacesTransformer {
+	return &subjectNamespacesTransformer{}
+}
+
+func (t *subjectNamespacesTransformer) GetType() string {
+	return "rewriteSubjectNamespaces"
+}
+
+func (t *subjectNamespacesTransformer) IsSupported(object client.Object) bool {
+	_, ok := object.(*rbacv1.RoleBinding)
+	return ok
+}
+
+func (t *subjectNamespacesTransformer) Validate(config map[string]string) error {
+	if len(config) > 0 {
+		return fmt.Errorf("configuration is not supported")
+	}
+	return nil
+}
+
+func (t *subjectNamespacesTransformer) Transform(ctx context.Context, transformCtx TransformContext,
+	config map[string]string, targetObject client.Object) error {
+	roleBinding, ok := targetObject.(*rbacv1.RoleBinding)
+	if !ok {
+		return fmt.Errorf("transform not supported for %T", targetObject)
+	}
+
+	for i, subject := range roleBinding.Subjects {
+		if subject.Kind == rbacv1.ServiceAccountKind && subject.Namespace == transformCtx.SourceObject.GetNamespace() {
+			roleBinding.Subjects[i].Namespace = transformCtx.TargetNamespace.GetName()
+		}
+	}
+	return nil
+}
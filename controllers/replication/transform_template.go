@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// templateTransformer renders data values as Go templates. The optional "keys" configuration limits
+// the rendered keys using comma-separated keys or glob patterns.
+type templateTransformer struct{}
+
+// templateData is the data available to the templates rendered by the template transform
+type templateData struct {
+	// Name is the name of the source object
+	Name string
+	// SourceNamespace is the namespace of the source object
+	SourceNamespace string
+	// Namespace is the target namespace (e.g. {{ .Namespace.Labels.team }})
+	Namespace *corev1.Namespace
+}
+
+func newTemplateTransformer() *templateTransformer {
+	return &templateTransformer{}
+}
+
+func (t *templateTransformer) GetType() string {
+	return "template"
+}
+
+func (t *templateTransformer) IsSupported(object client.Object) bool {
+	return isKeyedData(object)
+}
+
+func (t *templateTransformer) Validate(config map[string]string) error {
+	_, err := newConfigKeyFilter(config, "keys")
+	return err
+}
+
+func (t *templateTransformer) Transform(_ context.Context, transformCtx TransformContext, config map[string]string,
+	targetObject client.Object) error {
+	data, err := newKeyedData(targetObject)
+	if err != nil {
+		return err
+	}
+	filter, err := newConfigKeyFilter(config, "keys")
+	if err != nil {
+		return err
+	}
+
+	values := templateData{
+		Name:            transformCtx.SourceObject.GetName(),
+		SourceNamespace: transformCtx.SourceObject.GetNamespace(),
+		Namespace:       transformCtx.TargetNamespace,
+	}
+	for _, key := range data.Keys() {
+		if !filter(key) {
+			continue
+		}
+		value, _ := data.Get(key)
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(string(value))
+		if err != nil {
+			return fmt.Errorf("failed to parse template in key %s: %+w", key, err)
+		}
+		rendered := &bytes.Buffer{}
+		if err := tmpl.Execute(rendered, values); err != nil {
+			return fmt.Errorf("failed to render template in key %s: %+w", key, err)
+		}
+		data.Set(key, rendered.Bytes())
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Transforms", func() {
+	sourceConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-config",
+			Namespace: "platform",
+		},
+		Data: map[string]string{
+			"team":   "{{ .Namespace.Labels.team }}",
+			"source": "{{ .SourceNamespace }}/{{ .Name }}",
+			"token":  "c2VjcmV0",
+		},
+	}
+	targetNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "payments",
+			Labels: map[string]string{
+				"team": "payments-team",
+			},
+		},
+	}
+	sharedConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "shared-config",
+			Namespace: "platform",
+		},
+		Data: map[string]string{
+			"team":   "shared",
+			"region": "eu",
+		},
+	}
+
+	transform := func(ctx SpecContext, specs ...TransformSpec) (*corev1.ConfigMap, error) {
+		pipeline, err := NewTransformPipeline(sourceConfigMap, specs)
+		if err != nil {
+			return nil, err
+		}
+		targetConfigMap := &corev1.ConfigMap{}
+		newConfigMapReplicator().Replicate(sourceConfigMap.DeepCopy(), targetConfigMap)
+		err = pipeline.Apply(ctx, TransformContext{
+			Reader:          fake.NewClientBuilder().WithObjects(sharedConfigMap).Build(),
+			SourceObject:    sourceConfigMap,
+			TargetNamespace: targetNamespace,
+		}, targetConfigMap)
+		return targetConfigMap, err
+	}
+
+	It("Should register the built-in transforms", func() {
+		for _, transformer := range newTransformers() {
+			registeredTransformer, ok := GetTransformer(transformer.GetType())
+			Expect(ok).To(BeTrue())
+			Expect(registeredTransformer).To(Equal(transformer))
+		}
+	})
+
+	It("Should rename keys", func(ctx SpecContext) {
+		targetConfigMap, err := transform(ctx, TransformSpec{
+			Type:   "renameKeys",
+			Config: map[string]string{"token": "api-token"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("api-token", "c2VjcmV0"))
+		Expect(targetConfigMap.Data).NotTo(HaveKey("token"))
+	})
+
+	It("Should render templates using the namespace metadata", func(ctx SpecContext) {
+		targetConfigMap, err := transform(ctx, TransformSpec{
+			Type:   "template",
+			Config: map[string]string{"keys": "team,source"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("team", "payments-team"))
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("source", "platform/app-config"))
+		Expect(sourceConfigMap.Data).To(HaveKeyWithValue("team", "{{ .Namespace.Labels.team }}"))
+	})
+
+	It("Should re-encode values", func(ctx SpecContext) {
+		targetConfigMap, err := transform(ctx, TransformSpec{
+			Type:   "base64",
+			Config: map[string]string{"mode": "decode", "keys": "token"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("token", "secret"))
+	})
+
+	It("Should merge keys from other sources without overwriting", func(ctx SpecContext) {
+		targetConfigMap, err := transform(ctx, TransformSpec{
+			Type:   "mergeKeys",
+			Config: map[string]string{"sources": "shared-config"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("region", "eu"))
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("team", "{{ .Namespace.Labels.team }}"))
+	})
+
+	It("Should apply the transforms in order", func(ctx SpecContext) {
+		targetConfigMap, err := transform(ctx,
+			TransformSpec{Type: "mergeKeys", Config: map[string]string{"sources": "shared-config", "overwrite": "true"}},
+			TransformSpec{Type: "renameKeys", Config: map[string]string{"team": "owner"}},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(targetConfigMap.Data).To(HaveKeyWithValue("owner", "shared"))
+	})
+
+	It("Should rewrite the namespaces of service account subjects in the source namespace", func(ctx SpecContext) {
+		sourceRoleBinding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app-role-binding",
+				Namespace: "platform",
+			},
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.ServiceAccountKind, Name: "app", Namespace: "platform"},
+				{Kind: rbacv1.ServiceAccountKind, Name: "monitoring", Namespace: "monitoring"},
+				{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "platform"},
+			},
+		}
+		targetRoleBinding := &rbacv1.RoleBinding{}
+		newRoleBindingReplicator().Replicate(sourceRoleBinding.DeepCopy(), targetRoleBinding)
+
+		pipeline, err := NewTransformPipeline(sourceRoleBinding, []TransformSpec{{Type: "rewriteSubjectNamespaces"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pipeline.Apply(ctx, TransformContext{
+			SourceObject:    sourceRoleBinding,
+			TargetNamespace: targetNamespace,
+		}, targetRoleBinding)).To(Succeed())
+		Expect(targetRoleBinding.Subjects).To(Equal([]rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Name: "app", Namespace: "payments"},
+			{Kind: rbacv1.ServiceAccountKind, Name: "monitoring", Namespace: "monitoring"},
+			{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "platform"},
+		}))
+		Expect(sourceRoleBinding.Subjects[0].Namespace).To(Equal("platform"))
+	})
+
+	It("Should drop service account secret references missing in the target namespace", func(ctx SpecContext) {
+		sourceServiceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "app",
+				Namespace: "platform",
+			},
+			Secrets: []corev1.ObjectReference{
+				{Name: "app-token"},
+				{Name: "registry-credentials"},
+				{Name: "shared-credentials", Namespace: "shared"},
+			},
+		}
+		replicatedSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "registry-credentials",
+				Namespace: "payments",
+			},
+		}
+		targetServiceAccount := &corev1.ServiceAccount{}
+		newServiceAccountReplicator().Replicate(sourceServiceAccount.DeepCopy(), targetServiceAccount)
+
+		pipeline, err := NewTransformPipeline(sourceServiceAccount, []TransformSpec{{Type: "dropSecretReferences"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pipeline.Apply(ctx, TransformContext{
+			Reader:          fake.NewClientBuilder().Build(),
+			TargetReader:    fake.NewClientBuilder().WithObjects(replicatedSecret).Build(),
+			SourceObject:    sourceServiceAccount,
+			TargetNamespace: targetNamespace,
+		}, targetServiceAccount)).To(Succeed())
+		Expect(targetServiceAccount.Secrets).To(Equal([]corev1.ObjectReference{
+			{Name: "registry-credentials"},
+			{Name: "shared-credentials", Namespace: "shared"},
+		}))
+	})
+
+	It("Should reject transforms of unsupported kinds", func() {
+		_, err := NewTransformPipeline(sourceConfigMap, []TransformSpec{{Type: "rewriteSubjectNamespaces"}})
+		Expect(err).To(HaveOccurred())
+		_, err = NewTransformPipeline(sourceConfigMap, []TransformSpec{{Type: "dropSecretReferences"}})
+		Expect(err).To(HaveOccurred())
+		_, err = NewTransformPipeline(&rbacv1.RoleBinding{}, []TransformSpec{{
+			Type:   "mergeKeys",
+			Config: map[string]string{"sources": "shared-config"},
+		}})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should reject unknown and invalid transforms", func() {
+		_, err := NewTransformPipeline(sourceConfigMap, []TransformSpec{{Type: "unknown"}})
+		Expect(err).To(HaveOccurred())
+		_, err = NewTransformPipeline(sourceConfigMap, []TransformSpec{{Type: "base64", Config: map[string]string{"mode": "rot13"}}})
+		Expect(err).To(HaveOccurred())
+	})
+})
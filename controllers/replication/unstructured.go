@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewUnstructuredReplicator creates a replicator for an arbitrary namespaced resource kind which
+// copies the listed top-level fields (e.g. spec, data) from the source object to the replicas.
+func NewUnstructuredReplicator(gvk schema.GroupVersionKind, fields []string) Replicator {
+	return &unstructuredReplicator{
+		gvk:    gvk,
+		fields: fields,
+	}
+}
+
+type unstructuredReplicator struct {
+	gvk    schema.GroupVersionKind
+	fields []string
+}
+
+func (r *unstructuredReplicator) GetKind() string {
+	return r.gvk.Kind
+}
+
+func (r *unstructuredReplicator) AddToScheme(scheme *runtime.Scheme) error {
+	// Unstructured objects do not need to be registered in the scheme
+	return nil
+}
+
+func (r *unstructuredReplicator) EmptyObject() client.Object {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(r.gvk)
+	return object
+}
+
+func (r *unstructuredReplicator) EmptyObjectList() client.ObjectList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(r.gvk.GroupVersion().WithKind(r.gvk.Kind + "List"))
+	return list
+}
+
+func (r *unstructuredReplicator) ObjectListToArray(list client.ObjectList) []client.Object {
+	items := list.(*unstructured.UnstructuredList).Items
+	array := make([]client.Object, len(items))
+	for i := range items {
+		array[i] = &items[i]
+	}
+	return array
+}
+
+func (r *unstructuredReplicator) Replicate(sourceObject client.Object, targetObject client.Object) {
+	sourceContent := sourceObject.(*unstructured.Unstructured).Object
+	targetContent := targetObject.(*unstructured.Unstructured).Object
+
+	// Copy the configured top-level fields
+	for _, field := range r.fields {
+		if value, ok := sourceContent[field]; ok {
+			targetContent[field] = runtime.DeepCopyJSONValue(value)
+		} else {
+			delete(targetContent, field)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package replication
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("Unstructured Replicator", func() {
+	issuerGVK := schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Issuer"}
+
+	It("Should copy only the configured fields", func() {
+		replicator := NewUnstructuredReplicator(issuerGVK, []string{"spec", "data"})
+
+		sourceObject := replicator.EmptyObject().(*unstructured.Unstructured)
+		sourceObject.SetName("ca-issuer")
+		sourceObject.Object["spec"] = map[string]any{"ca": map[string]any{"secretName": "ca"}}
+		sourceObject.Object["status"] = map[string]any{"ready": true}
+
+		targetObject := replicator.EmptyObject().(*unstructured.Unstructured)
+		targetObject.Object["data"] = "stale"
+		replicator.Replicate(sourceObject, targetObject)
+
+		Expect(targetObject.Object["spec"]).To(Equal(sourceObject.Object["spec"]))
+		Expect(targetObject.Object).NotTo(HaveKey("data"))
+		Expect(targetObject.Object).NotTo(HaveKey("status"))
+		Expect(targetObject.GetName()).To(BeEmpty())
+		Expect(targetObject.GroupVersionKind()).To(Equal(issuerGVK))
+	})
+
+	It("Should load the replicators from a config file", func() {
+		configFile := filepath.Join(GinkgoT().TempDir(), "replicators.yaml")
+		Expect(os.WriteFile(configFile, []byte(`
+replicators:
+  - group: cert-manager.io
+    version: v1
+    kind: Issuer
+    fields: [spec]
+  - version: v1
+    kind: PodTemplate
+    fields: [template]
+`), 0o600)).To(Succeed())
+
+		replicators, err := LoadReplicators(configFile, NewReplicators())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replicators).To(HaveLen(2))
+		Expect(replicators[0].GetKind()).To(Equal("Issuer"))
+		Expect(replicators[1].EmptyObjectList().GetObjectKind().GroupVersionKind().Kind).To(Equal("PodTemplateList"))
+	})
+
+	It("Should reject kinds which are already replicated", func() {
+		configFile := filepath.Join(GinkgoT().TempDir(), "replicators.yaml")
+		Expect(os.WriteFile(configFile, []byte(`
+replicators:
+  - version: v1
+    kind: Secret
+    fields: [data]
+`), 0o600)).To(Succeed())
+
+		_, err := LoadReplicators(configFile, NewReplicators())
+		Expect(err).To(HaveOccurred())
+	})
+})
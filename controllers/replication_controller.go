@@ -16,27 +16,44 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
 	"github.com/nadundesilva/k8s-replicator/controllers/replication"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // ReplicationReconciler reconciles a replicated object
 type ReplicationReconciler struct {
 	client.Client
-	Scheme   *runtime.Scheme
-	recorder record.EventRecorder
+	Scheme    *runtime.Scheme
+	recorder  record.EventRecorder
+	apiReader client.Reader
 
 	Replicator replication.Replicator
+	// RemoteClusters holds the remote clusters sources can be replicated into (nil if disabled)
+	RemoteClusters *RemoteClusterRegistry
+
+	// replicaQueue holds the replica items retrying the failed namespaces (nil until the controller is started)
+	replicaQueue     workqueue.TypedRateLimitingInterface[replicaItem]
+	replicaQueueLock sync.RWMutex
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -55,6 +72,16 @@ func (r *ReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return ctrl.Result{}, fmt.Errorf("failed to get object being reconciled: %+w", err)
 		}
 	}
+	if isObjectDeleted && cacheMarkedObjectsOnly {
+		// Objects leave the cache once unmarked, and hence their replicas still need to be removed
+		if err := r.apiReader.Get(ctx, req.NamespacedName, object); err != nil {
+			if !errors.IsNotFound(err) {
+				return ctrl.Result{}, fmt.Errorf("failed to get object being reconciled: %+w", err)
+			}
+		} else {
+			isObjectDeleted = false
+		}
+	}
 	if !isObjectDeleted {
 		isObjectDeleted = object.GetDeletionTimestamp() != nil
 	}
@@ -77,7 +104,7 @@ func (r *ReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	case objectTypeLabelValueReplica:
 		ctx = log.IntoContext(ctx, log.FromContext(ctx).WithValues("replicaNamespace", object.GetNamespace()))
 
-		sourceStatus, err := getReplicaSourceStatus(ctx, r.Client, object, r.Replicator)
+		sourceStatus, err := getReplicaSourceStatus(ctx, r.Client, r.apiReader, object, r.Replicator)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -90,18 +117,36 @@ func (r *ReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				return ctrl.Result{}, removeFinalizer(ctx, r.Client, object)
 			} else {
 				logger.V(1).Info("Deleting replica")
-				return ctrl.Result{}, deleteObject(ctx, r.Client, object)
+				return ctrl.Result{}, deleteObject(ctx, r.Client, r.Replicator.GetKind(), object)
 			}
 		}
 		return ctrl.Result{}, nil
-	case objectTypeLabelValueReplicated:
+	case objectTypeLabelValueReplicated, objectTypeLabelValueRequestable:
 		ctx = log.IntoContext(ctx, log.FromContext(ctx).WithValues("sourceNamespace", object.GetNamespace()))
 
 		if isObjectDeleted {
 			return ctrl.Result{}, r.handleSourceRemoval(ctx, object)
-		} else {
-			return ctrl.Result{}, r.handleSourceUpdate(ctx, object)
 		}
+
+		isAllowed, err := isSourceAllowed(ctx, r.Client, r.Replicator.GetKind(), object.GetNamespace())
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if !isAllowed {
+			log.FromContext(ctx).V(1).Info("Removing replicas of source object not allowed by cluster replication policies")
+			r.recorder.Eventf(object, "Warning", SourceNotAllowed,
+				"replicating %s from namespace %s is not allowed by cluster replication policies",
+				r.Replicator.GetKind(), object.GetNamespace())
+			return ctrl.Result{}, r.handleSourceRemoval(ctx, object)
+		}
+		if err := r.handleSourceUpdate(ctx, object); err != nil {
+			return ctrl.Result{}, err
+		}
+		if r.RemoteClusters != nil && len(getSourceTargetClusters(object)) > 0 {
+			// Namespaces created in the remote clusters are not watched
+			return ctrl.Result{RequeueAfter: remoteClusterSyncInterval}, nil
+		}
+		return ctrl.Result{}, nil
 	default:
 		logger := log.FromContext(ctx).WithValues("objectType", objectType)
 		if controllerutil.ContainsFinalizer(object, resourceFinalizer) {
@@ -115,34 +160,53 @@ func (r *ReplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 }
 
 func (r *ReplicationReconciler) handleSourceRemoval(ctx context.Context, object client.Object) error {
-	err := r.iterateNamespaces(ctx, func(ns corev1.Namespace) error {
-		if ns.GetName() == object.GetNamespace() {
-			return nil
-		}
+	err := r.removeSource(ctx, object)
+	if err != nil {
+		recordReplicationError(r.Replicator.GetKind(), errorReasonSourceRemovalFailed)
+	}
+	return err
+}
 
-		replica := r.Replicator.EmptyObject()
-		err := r.Get(ctx, client.ObjectKey{Namespace: ns.GetName(), Name: object.GetName()}, replica)
-		if err != nil {
-			if errors.IsNotFound(err) {
-				return nil
-			} else {
-				return err
-			}
+// removeSource deletes all the replicas of a source object, and releases the source object
+func (r *ReplicationReconciler) removeSource(ctx context.Context, object client.Object) error {
+	err := deleteStaleReplicas(ctx, r.Client, r.recorder, nil, object, r.Replicator, "source object deleted",
+		func(replica client.Object) bool {
+			return false
+		})
+	if err != nil {
+		return fmt.Errorf("failed to finalize source object: %+w", err)
+	}
+
+	clusters, err := r.RemoteClusters.list(ctx, r.Client, r.Scheme)
+	if err != nil {
+		return fmt.Errorf("failed to finalize source object: %+w", err)
+	}
+	for _, cluster := range clusters {
+		err := deleteStaleReplicas(ctx, r.Client, r.recorder, cluster, object, r.Replicator, "source object deleted",
+			func(replica client.Object) bool {
+				return false
+			})
+		if err != nil && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("failed to finalize source object in cluster %s: %+w", cluster.name, err)
 		}
+	}
+
+	err = deleteReplicationStatus(ctx, r.Client, r.Replicator.GetKind(), object)
+	if err != nil {
+		return err
+	}
 
-		log.FromContext(ctx).V(1).Info("Deleting replica", "replicaNamespace", ns.GetName(),
-			"reason", "source object deleted")
-		err = deleteObject(ctx, r.Client, replica)
+	requests, err := findReplicaRequests(ctx, r.Client, "", r.Replicator.GetKind(), object)
+	if err != nil {
+		return err
+	}
+	for _, request := range requests {
+		err := updateReplicaRequestStatus(ctx, r.Client, request, newFailedReplicaRequestResult(
+			requestConditionReasonSourceUnavailable, "source object is not available for replication"))
 		if err != nil {
 			return err
 		}
-		r.recorder.Eventf(object, "Normal", SourceObjectDelete, "replica in namespace %s deleted", ns.GetName())
-		return nil
-	})
-	if err != nil {
-		return fmt.Errorf("failed to finalize source object: %+w", err)
 	}
-
 	return removeFinalizer(ctx, r.Client, object)
 }
 
@@ -152,65 +216,327 @@ func (r *ReplicationReconciler) handleSourceUpdate(ctx context.Context, object c
 		return err
 	}
 
-	err = r.iterateNamespaces(ctx, func(ns corev1.Namespace) error {
+	matcher, err := newTargetNamespaceMatcher(object)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Ignoring source object with invalid target namespaces")
+		r.recorder.Eventf(object, "Warning", InvalidTargetNamespaces, "invalid target namespaces: %v", err)
+		return nil
+	}
+	if reason, err := validateSourceReplicaOptions(object, object.GetNamespace()); err != nil {
+		log.FromContext(ctx).Error(err, "Ignoring source object with invalid replica options")
+		r.recorder.Eventf(object, "Warning", reason, "%v", err)
+		return nil
+	}
+
+	// Requestable sources are only replicated into the namespaces requesting them
+	requests := []*v1alpha1.ReplicaRequest{}
+	if isRequestable(object) {
+		requests, err = findReplicaRequests(ctx, r.Client, "", r.Replicator.GetKind(), object)
+		if err != nil {
+			return err
+		}
+	}
+	requestResults := map[string]replicaRequestResult{}
+	for _, request := range requests {
+		requestResults[request.GetNamespace()] = newFailedReplicaRequestResult(
+			requestConditionReasonNamespaceNotTargeted, "namespace is not targeted by the source object")
+	}
+
+	// Guards the replica statuses and the request results updated while replicating into the namespaces
+	lock := sync.Mutex{}
+	replicaStatuses := []v1alpha1.ReplicaStatus{}
+	err = iterateNamespaces(ctx, r.Client, func(ctx context.Context, ns corev1.Namespace) error {
 		if ns.GetName() == object.GetNamespace() {
 			return nil
 		}
+		lock.Lock()
+		_, isRequested := requestResults[ns.GetName()]
+		lock.Unlock()
 
-		log.FromContext(ctx).V(1).Info("Creating/Updating replica", "replicaNamespace", ns.GetName())
-		return replicateObject(ctx, r.Client, r.recorder, ns.GetName(), object, r.Replicator)
+		status, err := r.syncNamespace(ctx, object, matcher, &ns, isRequested)
+		isRetried := false
+		if ignoreReplicaConflict(err) != nil {
+			// The namespace is retried on its own, instead of retrying all the namespaces of the source
+			isRetried = r.retryReplicaItem(object, ns.GetName())
+			if isRetried {
+				log.FromContext(ctx).V(1).Info("Retrying failed namespace", "replicaNamespace", ns.GetName(),
+					"reason", err.Error())
+			}
+		}
+		if status != nil {
+			if isRetried {
+				*status = newRetriedReplicaStatus(ns.GetName(), err)
+			}
+			lock.Lock()
+			replicaStatuses = append(replicaStatuses, *status)
+			if isRequested {
+				requestResults[ns.GetName()] = newReplicaRequestResult(err)
+			}
+			lock.Unlock()
+		}
+		if ignoreReplicaConflict(err) != nil && !isRetried {
+			return err
+		}
+		return nil
 	})
-	return err
+	requestErrs := []error{}
+	for _, request := range requests {
+		requestErr := updateReplicaRequestStatus(ctx, r.Client, request, requestResults[request.GetNamespace()])
+		if requestErr != nil {
+			requestErrs = append(requestErrs, requestErr)
+		}
+	}
+
+	statusErr := updateReplicationStatus(ctx, r.Client, r.apiReader, r.Replicator.GetKind(), object,
+		setReplicaStatuses(replicaStatuses, true))
+	if err != nil {
+		return err
+	}
+
+	err = deleteStaleReplicas(ctx, r.Client, r.recorder, nil, object, r.Replicator, "target name changed",
+		func(replica client.Object) bool {
+			replicaName, err := getReplicaName(object, replica.GetNamespace())
+			return err != nil || replica.GetName() == replicaName
+		})
+	if err != nil {
+		return err
+	}
+
+	err = r.replicateToRemoteClusters(ctx, object, matcher)
+	if err != nil {
+		return err
+	}
+	if len(requestErrs) > 0 {
+		return fmt.Errorf("failed to update replica requests: %+v", requestErrs)
+	}
+	return statusErr
 }
 
-func (r *ReplicationReconciler) iterateNamespaces(ctx context.Context, handler func(ns corev1.Namespace) error) error {
-	namespaceList := &corev1.NamespaceList{}
-	err := r.List(ctx, namespaceList, &client.ListOptions{
-		LabelSelector: namespaceSelector,
+// syncNamespace replicates a source object into a namespace, or deletes its replica if the namespace is
+// not targeted by the source object. The status of the replica is returned if the namespace is targeted.
+func (r *ReplicationReconciler) syncNamespace(ctx context.Context, object client.Object, matcher *namespaceMatcher,
+	ns *corev1.Namespace, isRequested bool) (*v1alpha1.ReplicaStatus, error) {
+	if !matcher.Matches(ns) || (isRequestable(object) && !isRequested) {
+		return nil, deleteReplica(ctx, r.Client, r.recorder, ns.GetName(), object, r.Replicator,
+			"namespace not targeted by source object")
+	}
+
+	log.FromContext(ctx).V(1).Info("Creating/Updating replica", "replicaNamespace", ns.GetName())
+	err := replicateObject(ctx, r.Client, r.recorder, ns.GetName(), object, r.Replicator, replicaOptions{
+		conflictPolicy: getSourceConflictPolicy(object),
+		apiReader:      r.apiReader,
 	})
+	status := newReplicaStatus(ns.GetName(), object, err)
+	return &status, err
+}
+
+// replicateToRemoteClusters replicates a source object into the namespaces of the remote clusters
+// targeted by it, and deletes its replicas from the remote clusters and namespaces no longer targeted
+func (r *ReplicationReconciler) replicateToRemoteClusters(ctx context.Context, object client.Object,
+	matcher *namespaceMatcher) error {
+	clusters, err := r.RemoteClusters.list(ctx, r.Client, r.Scheme)
 	if err != nil {
 		return err
 	}
 
 	errs := []error{}
-	for _, ns := range namespaceList.Items {
-		if isNamespaceIgnored(&ns) || ns.GetDeletionTimestamp() != nil {
-			continue
+	targetClusters := getSourceTargetClusters(object)
+	if isRequestable(object) {
+		// Remote clusters cannot request sources, and hence requestable sources are not replicated into them
+		targetClusters = map[string]bool{}
+	}
+	for _, cluster := range clusters {
+		ctx := log.IntoContext(ctx, log.FromContext(ctx).WithValues("cluster", cluster.name))
+
+		desiredReplicas := map[string]string{}
+		desiredReplicasLock := sync.Mutex{}
+		if targetClusters[cluster.name] {
+			err := iterateNamespaces(ctx, cluster.client, func(ctx context.Context, ns corev1.Namespace) error {
+				if !matcher.Matches(&ns) {
+					return nil
+				}
+				replicaName, err := getReplicaName(object, ns.GetName())
+				if err != nil {
+					return err
+				}
+				desiredReplicasLock.Lock()
+				desiredReplicas[ns.GetName()] = replicaName
+				desiredReplicasLock.Unlock()
+
+				log.FromContext(ctx).V(1).Info("Creating/Updating replica", "replicaNamespace", ns.GetName())
+				return ignoreReplicaConflict(replicateObject(ctx, r.Client, r.recorder, ns.GetName(), object,
+					r.Replicator, replicaOptions{
+						conflictPolicy: getSourceConflictPolicy(object),
+						cluster:        cluster,
+						apiReader:      r.apiReader,
+					}))
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to replicate into cluster %s: %+w", cluster.name, err))
+				continue
+			}
 		}
 
-		err = handler(ns)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed to run reconciliation for namespace %s: %+w", ns.GetName(), err))
-			continue
+		err := deleteStaleReplicas(ctx, r.Client, r.recorder, cluster, object, r.Replicator,
+			"namespace not targeted by source object", func(replica client.Object) bool {
+				replicaName, ok := desiredReplicas[replica.GetNamespace()]
+				return ok && replica.GetName() == replicaName
+			})
+		if err != nil && !meta.IsNoMatchError(err) {
+			// Remote clusters not serving the kind do not contain any replicas
+			errs = append(errs, fmt.Errorf("failed to delete stale replicas in cluster %s: %+w", cluster.name, err))
 		}
 	}
 	if len(errs) > 0 {
-		return fmt.Errorf("failed to iterate namespaces: %+v", errs)
+		return fmt.Errorf("failed to replicate into remote clusters: %+v", errs)
 	}
 	return nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ReplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	predicate := predicate.NewPredicateFuncs(func(object client.Object) bool {
-		objectType, objectTypeOk := object.GetLabels()[objectTypeLabelKey]
-		if objectTypeOk && (objectType == objectTypeLabelValueReplicated || objectType == objectTypeLabelValueReplica) {
-			return true
+	name := r.setup(mgr)
+	if err := setupFieldIndexes(context.Background(), mgr.GetFieldIndexer(), r.Replicator.EmptyObject()); err != nil {
+		return err
+	}
+	if err := setupReplicationStatusCache(mgr); err != nil {
+		return err
+	}
+	if _, err := r.newReplicaItemController(mgr, true); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(r.Replicator.EmptyObject(), builder.WithPredicates(r.objectPredicate())).
+		Watches(&v1alpha1.ClusterReplicationPolicy{}, handler.EnqueueRequestsFromMapFunc(r.mapToAllSources)).
+		Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(r.mapNamespaceToSources),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Watches(r.Replicator.EmptyObject(), handler.EnqueueRequestsFromMapFunc(r.findCompetingSources),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isReplica))).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.mapToAllSources),
+			builder.WithPredicates(r.clusterSecretPredicate())).
+		WithOptions(newManagerOptions(mgr, name, r.Replicator.GetKind())).
+		Complete(newTracingReconciler(name, r))
+}
+
+// NewUnmanagedControllers creates the controllers of the kind which are not started by the Manager. This
+// allows the controllers of a kind added at runtime to be started and stopped along with the kind.
+// The indexes of the kind need to be added before the controllers are started.
+func (r *ReplicationReconciler) NewUnmanagedControllers(mgr ctrl.Manager) ([]manager.Runnable, error) {
+	name := r.setup(mgr)
+	options := newManagerOptions(mgr, name, r.Replicator.GetKind())
+	options.Reconciler = newTracingReconciler(name, r)
+	options.SkipNameValidation = ptr.To(true) // The controller is re-created whenever the kind is updated
+	options.DefaultFromConfig(mgr.GetControllerOptions())
+	c, err := controller.NewUnmanaged(name, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller: %+w", err)
+	}
+
+	sources := []source.Source{
+		source.Kind(mgr.GetCache(), r.Replicator.EmptyObject(), &handler.EnqueueRequestForObject{},
+			r.objectPredicate()),
+		source.Kind(mgr.GetCache(), client.Object(&v1alpha1.ClusterReplicationPolicy{}),
+			handler.EnqueueRequestsFromMapFunc(r.mapToAllSources)),
+		source.Kind(mgr.GetCache(), client.Object(&corev1.Namespace{}),
+			handler.EnqueueRequestsFromMapFunc(r.mapNamespaceToSources), predicate.LabelChangedPredicate{}),
+		source.Kind(mgr.GetCache(), r.Replicator.EmptyObject(), handler.EnqueueRequestsFromMapFunc(r.findCompetingSources),
+			predicate.NewPredicateFuncs(isReplica)),
+		source.Kind(mgr.GetCache(), client.Object(&corev1.Secret{}), handler.EnqueueRequestsFromMapFunc(r.mapToAllSources),
+			r.clusterSecretPredicate()),
+	}
+	for _, src := range sources {
+		if err := c.Watch(src); err != nil {
+			return nil, fmt.Errorf("failed to watch resources: %+w", err)
 		}
-		return controllerutil.ContainsFinalizer(object, resourceFinalizer)
-	})
+	}
 
+	replicaItemController, err := r.newReplicaItemController(mgr, false)
+	if err != nil {
+		return nil, err
+	}
+	return []manager.Runnable{c, replicaItemController}, nil
+}
+
+func (r *ReplicationReconciler) setup(mgr ctrl.Manager) string {
 	name := fmt.Sprintf("replicator-%s-controller", strings.ToLower(r.Replicator.GetKind()))
 	r.recorder = mgr.GetEventRecorderFor(name)
+	r.apiReader = mgr.GetAPIReader()
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
 	if r.Scheme == nil {
 		r.Scheme = mgr.GetScheme()
 	}
-	return ctrl.NewControllerManagedBy(mgr).
-		Named(name).
-		For(r.Replicator.EmptyObject(), builder.WithPredicates(predicate)).
-		WithOptions(newManagerOptions(mgr, name, r.Replicator.GetKind())).
-		Complete(r)
+	return name
+}
+
+// objectPredicate filters the objects which are marked for replication or were replicated
+func (r *ReplicationReconciler) objectPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		objectType, objectTypeOk := object.GetLabels()[objectTypeLabelKey]
+		if objectTypeOk && (objectType == objectTypeLabelValueReplicated || objectType == objectTypeLabelValueRequestable ||
+			objectType == objectTypeLabelValueReplica) {
+			return true
+		}
+		return controllerutil.ContainsFinalizer(object, resourceFinalizer)
+	})
+}
+
+// clusterSecretPredicate filters the kubeconfig Secrets of the remote clusters
+func (r *ReplicationReconciler) clusterSecretPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(object client.Object) bool {
+		return r.RemoteClusters != nil && r.RemoteClusters.isClusterSecret(object)
+	})
+}
+
+func (r *ReplicationReconciler) mapToAllSources(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.findSources(ctx, "")
+}
+
+func (r *ReplicationReconciler) mapNamespaceToSources(ctx context.Context, ns client.Object) []reconcile.Request {
+	return r.findSources(ctx, ns.GetName())
+}
+
+// findSources finds the source objects in a namespace for re-evaluating the cluster replication policies
+func (r *ReplicationReconciler) findSources(ctx context.Context, namespace string) []reconcile.Request {
+	sourceList := r.Replicator.EmptyObjectList()
+	err := r.List(ctx, sourceList, client.InNamespace(namespace),
+		client.MatchingFields{objectTypeIndexField: objectTypeIndexValueSource})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list source objects", "objectKind", r.Replicator.GetKind())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, source := range r.Replicator.ObjectListToArray(sourceList) {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(source),
+		})
+	}
+	return requests
+}
+
+// findCompetingSources finds the sources with the same name as a replica in namespaces other than
+// the namespace of its current source, for re-evaluating which source the replica belongs to
+func (r *ReplicationReconciler) findCompetingSources(ctx context.Context, replica client.Object) []reconcile.Request {
+	sourceList := r.Replicator.EmptyObjectList()
+	err := r.List(ctx, sourceList, client.MatchingFields{objectTypeIndexField: objectTypeIndexValueSource})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list source objects", "objectKind", r.Replicator.GetKind())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, source := range r.Replicator.ObjectListToArray(sourceList) {
+		if source.GetNamespace() == replica.GetNamespace() || isReplicaOf(replica, source) {
+			continue
+		}
+		if replicaName, err := getReplicaName(source, replica.GetNamespace()); err == nil && replicaName == replica.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(source),
+			})
+		}
+	}
+	return requests
 }
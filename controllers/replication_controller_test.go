@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
 	. "github.com/nadundesilva/k8s-replicator/test/utils/gomega"
 	"github.com/nadundesilva/k8s-replicator/test/utils/testdata"
 	. "github.com/onsi/ginkgo/v2"
@@ -57,7 +58,7 @@ var _ = Describe("Object Replication", func() {
 			})
 
 			AfterEach(func(ctx SpecContext) {
-				Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, sourceObject))).To(Succeed())
 				sourceObject = nil
 
 				deleteNamespace(ctx, sourceNamespace)
@@ -117,6 +118,53 @@ var _ = Describe("Object Replication", func() {
 					validateNoReplication(ctx, sourceObject, resource, kubeNamespaces...)
 				}, testTimeout)
 
+				It("Should not replicate to namespaces matching excluded patterns", func(ctx SpecContext) {
+					// The excluded patterns are set before the suite starts, and invalid patterns are not applied
+					Expect(SetExcludedNamespaces([]string{"excluded-["})).NotTo(Succeed())
+
+					normalNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+					excludedNamespaces := nc.CreateNamespaces(ctx, "excluded", 2, nil)
+					kubeNamespaces := nc.CreateNamespaces(ctx, "kube", 1, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, normalNamespaces...)
+					validateNoReplication(ctx, sourceObject, resource, excludedNamespaces...)
+					validateNoReplication(ctx, sourceObject, resource, kubeNamespaces...)
+				}, testTimeout)
+
+				It("Should remove the replicas from namespaces which become excluded", func(ctx SpecContext) {
+					normalNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					excludedNamespaces := nc.CreateNamespaces(ctx, "excluded", 2, map[string]string{
+						namespaceTypeLabelKey: namespaceTypeLabelValueManaged,
+					})
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, append(normalNamespaces, excludedNamespaces...)...)
+
+					By("removing the managed label from the namespaces matching the excluded patterns")
+					for _, ns := range excludedNamespaces {
+						Eventually(func() error {
+							latestNamespace := &corev1.Namespace{}
+							if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(ns), latestNamespace); err != nil {
+								return err
+							}
+							delete(latestNamespace.GetLabels(), namespaceTypeLabelKey)
+							return k8sClient.Update(ctx, latestNamespace)
+						}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+					}
+					validateReplicaRemoval(ctx, sourceObject, resource, excludedNamespaces...)
+					validateReplication(ctx, sourceObject, resource, normalNamespaces...)
+				}, testTimeout)
+
+				It("Should replicate to namespaces with limited namespace parallelism", func(ctx SpecContext) {
+					// The namespace parallelism is set before the suite starts, and invalid values are not applied
+					Expect(SetNamespaceParallelism(0)).NotTo(Succeed())
+
+					normalNamespaces := nc.CreateNamespaces(ctx, "test-ns", 5, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, normalNamespaces...)
+				}, testTimeout)
+
 				It("Should not replicate to operator namespace", func(ctx SpecContext) {
 					normalNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
 
@@ -169,11 +217,498 @@ var _ = Describe("Object Replication", func() {
 					validateReplication(ctx, sourceObject, resource, operatorNs)
 					operatorNamespace = ""
 				}, testTimeout)
+
+				It("Should replicate only to namespaces with managed label in opt-in mode", func(ctx SpecContext) {
+					SetNamespaceOptIn(true)
+					defer SetNamespaceOptIn(false)
+
+					labels := map[string]string{
+						namespaceTypeLabelKey: namespaceTypeLabelValueManaged,
+					}
+					managedNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, labels)
+					normalNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, managedNamespaces...)
+					validateNoReplication(ctx, sourceObject, resource, normalNamespaces...)
+				}, testTimeout)
+			})
+
+			Context("When targeting namespaces using a label selector", func() {
+				targetLabels := map[string]string{
+					"unit-test-team": "team-a",
+				}
+
+				BeforeEach(func(ctx SpecContext) {
+					setAnnotation(sourceObject, targetNamespaceSelectorAnnotationKey, "unit-test-team=team-a")
+				})
+
+				It("Should replicate only to namespaces matching the selector", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, targetLabels)
+					otherNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+					validateNoReplication(ctx, sourceObject, resource, otherNamespaces...)
+				}, testTimeout)
+
+				It("Should remove replicas from namespaces which stop matching the selector", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, targetLabels)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					updatedNamespace := targetNamespaces[0]
+					Eventually(func() error {
+						ns := &corev1.Namespace{}
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(updatedNamespace), ns)
+						if err != nil {
+							return err
+						}
+						ns.SetLabels(map[string]string{"unit-test-team": "team-b"})
+						return k8sClient.Update(ctx, ns)
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+
+					validateReplicaRemoval(ctx, sourceObject, resource, updatedNamespace)
+					validateReplication(ctx, sourceObject, resource, targetNamespaces[1:]...)
+				}, testTimeout)
+
+				It("Should move replicas when the selector is changed", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, targetLabels)
+					newTargetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, map[string]string{
+						"unit-test-team": "team-b",
+					})
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					Eventually(func() error {
+						latestSourceObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject)
+						if err != nil {
+							return err
+						}
+						setAnnotation(latestSourceObject, targetNamespaceSelectorAnnotationKey, "unit-test-team=team-b")
+						return k8sClient.Update(ctx, latestSourceObject)
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+					setAnnotation(sourceObject, targetNamespaceSelectorAnnotationKey, "unit-test-team=team-b")
+
+					validateReplicaRemoval(ctx, sourceObject, resource, targetNamespaces...)
+					validateReplication(ctx, sourceObject, resource, newTargetNamespaces...)
+				}, testTimeout)
+			})
+
+			Context("When cluster replication policies are present", func() {
+				var clusterPolicy *v1alpha1.ClusterReplicationPolicy
+
+				BeforeEach(func(ctx SpecContext) {
+					clusterPolicy = &v1alpha1.ClusterReplicationPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "test-cluster-policy-" + uuid.New().String(),
+						},
+					}
+				})
+
+				AfterEach(func(ctx SpecContext) {
+					Expect(k8sClient.Delete(ctx, clusterPolicy)).To(Succeed())
+					clusterPolicy = nil
+				})
+
+				It("Should replicate sources allowed by the policy", func(ctx SpecContext) {
+					clusterPolicy.Spec.SourceNamespaces.Namespaces = []string{sourceNamespace.GetName()}
+					clusterPolicy.Spec.AllowedKinds = []string{resource.Name}
+					Expect(k8sClient.Create(ctx, clusterPolicy)).To(Succeed())
+
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+				}, testTimeout)
+
+				It("Should not replicate sources from namespaces not allowed by the policy", func(ctx SpecContext) {
+					clusterPolicy.Spec.SourceNamespaces.Namespaces = []string{"allowed-source-ns-*"}
+					Expect(k8sClient.Create(ctx, clusterPolicy)).To(Succeed())
+
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateNoReplication(ctx, sourceObject, resource, targetNamespaces...)
+				}, testTimeout)
+
+				It("Should not replicate kinds not allowed by the policy", func(ctx SpecContext) {
+					clusterPolicy.Spec.SourceNamespaces.Namespaces = []string{sourceNamespace.GetName()}
+					clusterPolicy.Spec.AllowedKinds = []string{"UnknownKind"}
+					Expect(k8sClient.Create(ctx, clusterPolicy)).To(Succeed())
+
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateNoReplication(ctx, sourceObject, resource, targetNamespaces...)
+				}, testTimeout)
+
+				It("Should remove existing replicas once the source is no longer allowed", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					clusterPolicy.Spec.SourceNamespaces.Namespaces = []string{"allowed-source-ns-*"}
+					Expect(k8sClient.Create(ctx, clusterPolicy)).To(Succeed())
+
+					validateReplicaRemoval(ctx, sourceObject, resource, targetNamespaces...)
+				}, testTimeout)
+			})
+
+			Context("When targeting namespaces using name patterns", func() {
+				BeforeEach(func(ctx SpecContext) {
+					setAnnotation(sourceObject, targetNamespacesAnnotationKey, "team-a-*, !team-a-legacy-*")
+				})
+
+				It("Should replicate only to included namespaces which are not excluded", func(ctx SpecContext) {
+					includedNamespaces := nc.CreateNamespaces(ctx, "team-a", 3, nil)
+					excludedNamespaces := nc.CreateNamespaces(ctx, "team-a-legacy", 2, nil)
+					otherNamespaces := nc.CreateNamespaces(ctx, "team-b", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, includedNamespaces...)
+					validateNoReplication(ctx, sourceObject, resource, excludedNamespaces...)
+					validateNoReplication(ctx, sourceObject, resource, otherNamespaces...)
+				}, testTimeout)
+
+				It("Should remove replicas from newly excluded namespaces", func(ctx SpecContext) {
+					includedNamespaces := nc.CreateNamespaces(ctx, "team-a", 2, nil)
+					newlyExcludedNamespace := nc.CreateNamespaces(ctx, "team-a", 1, nil)[0]
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, includedNamespaces...)
+					validateReplication(ctx, sourceObject, resource, newlyExcludedNamespace)
+
+					patterns := "team-a-*, !" + newlyExcludedNamespace.GetName()
+					Eventually(func() error {
+						latestSourceObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject)
+						if err != nil {
+							return err
+						}
+						setAnnotation(latestSourceObject, targetNamespacesAnnotationKey, patterns)
+						return k8sClient.Update(ctx, latestSourceObject)
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+					setAnnotation(sourceObject, targetNamespacesAnnotationKey, patterns)
+
+					validateReplicaRemoval(ctx, sourceObject, resource, newlyExcludedNamespace)
+					validateReplication(ctx, sourceObject, resource, includedNamespaces...)
+				}, testTimeout)
+			})
+
+			Context("When reporting replication status", func() {
+				BeforeEach(func(ctx SpecContext) {
+					setAnnotation(sourceObject, targetNamespacesAnnotationKey, "status-ns-*")
+				})
+
+				It("Should report the replicas in the target namespaces", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "status-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					for _, ns := range targetNamespaces {
+						replicaStatus := getReplicaStatus(ctx, sourceObject, resource, ns)
+						Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateSynced))
+						Expect(replicaStatus.LastSyncTime).NotTo(BeNil())
+						Expect(replicaStatus.SourceResourceVersion).NotTo(BeEmpty())
+					}
+				}, testTimeout)
+
+				It("Should not update the replication status while the source is unchanged", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "status-ns", 1, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+					replicaStatus := getReplicaStatus(ctx, sourceObject, resource, targetNamespaces[0])
+					Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateSynced))
+
+					statusKey := client.ObjectKey{
+						Namespace: sourceObject.GetNamespace(),
+						Name:      replicationStatusName(resource.Name, sourceObject.GetName()),
+					}
+					replicationStatus := &v1alpha1.ReplicationStatus{}
+					Expect(k8sClient.Get(ctx, statusKey, replicationStatus)).To(Succeed())
+
+					By("reconciling the source object again")
+					Eventually(func() error {
+						ns := &corev1.Namespace{}
+						if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(targetNamespaces[0]), ns); err != nil {
+							return err
+						}
+						ns.Labels["test-label"] = "test-value"
+						return k8sClient.Update(ctx, ns)
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+					nc.CreateNamespaces(ctx, "other-ns", 1, nil)
+					Consistently(func() string {
+						latestStatus := &v1alpha1.ReplicationStatus{}
+						if err := k8sClient.Get(ctx, statusKey, latestStatus); err != nil {
+							return err.Error()
+						}
+						return latestStatus.GetResourceVersion()
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(replicationStatus.GetResourceVersion()))
+				}, testTimeout)
+
+				It("Should report the replicas in new target namespaces", func(ctx SpecContext) {
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					targetNamespaces := nc.CreateNamespaces(ctx, "status-ns", 2, nil)
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					for _, ns := range targetNamespaces {
+						replicaStatus := getReplicaStatus(ctx, sourceObject, resource, ns)
+						Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateSynced))
+					}
+				}, testTimeout)
+			})
+
+			Context("When unmanaged objects exist in target namespaces", func() {
+				var conflictNamespace *corev1.Namespace
+				var unmanagedObject client.Object
+
+				BeforeEach(func(ctx SpecContext) {
+					conflictNamespace = nc.CreateNamespaces(ctx, "conflict-ns", 1, nil)[0]
+					unmanagedObject = resource.SourceObject()
+					unmanagedObject.SetNamespace(conflictNamespace.GetName())
+					unmanagedObject.SetName(sourceObject.GetName())
+					delete(unmanagedObject.GetLabels(), objectTypeLabelKey)
+					Expect(k8sClient.Create(ctx, unmanagedObject)).To(Succeed())
+				})
+
+				It("Should not overwrite the unmanaged object", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					replicaStatus := getReplicaStatus(ctx, sourceObject, resource, conflictNamespace)
+					Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateConflict))
+					Consistently(func() bool {
+						existingObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(unmanagedObject), existingObject)
+						if err != nil {
+							return false
+						}
+						_, isReplica := existingObject.GetLabels()[objectTypeLabelKey]
+						return !isReplica
+					}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+				}, testTimeout)
+
+				It("Should adopt the unmanaged object if requested by the source", func(ctx SpecContext) {
+					setAnnotation(sourceObject, conflictPolicyAnnotationKey, string(v1alpha1.ConflictPolicyAdopt))
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					Eventually(func() string {
+						adoptedObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(unmanagedObject), adoptedObject)
+						if err != nil {
+							return ""
+						}
+						return adoptedObject.GetLabels()[objectTypeLabelKey]
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(objectTypeLabelValueReplica))
+					validateReplication(ctx, sourceObject, resource, conflictNamespace)
+				}, testTimeout)
+
+				It("Should not adopt the unmanaged object selected as a source by a policy", func(ctx SpecContext) {
+					policy := &v1alpha1.ReplicationPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-policy",
+							Namespace: conflictNamespace.GetName(),
+						},
+						Spec: v1alpha1.ReplicationPolicySpec{
+							Sources: []v1alpha1.SourceSelector{
+								{
+									Kind: resource.Name,
+									Name: unmanagedObject.GetName(),
+								},
+							},
+							Targets: v1alpha1.NamespaceSelection{
+								Namespaces: []string{"policy-target-*"},
+							},
+						},
+					}
+					Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+					defer func() {
+						Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, policy))).To(Succeed())
+					}()
+					setAnnotation(sourceObject, conflictPolicyAnnotationKey, string(v1alpha1.ConflictPolicyAdopt))
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					replicaStatus := getReplicaStatus(ctx, sourceObject, resource, conflictNamespace)
+					Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateConflict))
+					Consistently(func() bool {
+						existingObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(unmanagedObject), existingObject)
+						if err != nil {
+							return false
+						}
+						_, isReplica := existingObject.GetLabels()[objectTypeLabelKey]
+						return !isReplica
+					}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+				}, testTimeout)
+			})
+
+			Context("When same-named sources exist in different namespaces", func() {
+				var competingNamespace *corev1.Namespace
+				var competingSourceObject client.Object
+
+				BeforeEach(func(ctx SpecContext) {
+					competingNamespace = nc.CreateNamespaces(ctx, "competing-source-ns", 1, nil)[0]
+					competingSourceObject = resource.SourceObject()
+					competingSourceObject.SetNamespace(competingNamespace.GetName())
+					competingSourceObject.SetName(sourceObject.GetName())
+					competingSourceObject.GetLabels()[objectTypeLabelKey] = objectTypeLabelValueReplicated
+				})
+
+				AfterEach(func(ctx SpecContext) {
+					Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, competingSourceObject))).To(Succeed())
+					competingSourceObject = nil
+				})
+
+				It("Should replicate the source with the highest priority when created concurrently", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 3, nil)
+					setAnnotation(competingSourceObject, priorityAnnotationKey, "10")
+
+					done := make(chan struct{})
+					go func() {
+						defer GinkgoRecover()
+						defer close(done)
+						Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					}()
+					Expect(k8sClient.Create(ctx, competingSourceObject)).To(Succeed())
+					<-done
+
+					validateReplication(ctx, competingSourceObject, resource, targetNamespaces...)
+					for _, ns := range targetNamespaces {
+						lookupKey := client.ObjectKey{Namespace: ns.GetName(), Name: sourceObject.GetName()}
+						Consistently(func() string {
+							replica := resource.EmptyObject()
+							err := k8sClient.Get(ctx, lookupKey, replica)
+							if err != nil {
+								return ""
+							}
+							return replica.GetAnnotations()[sourceNamespaceAnnotationKey]
+						}, time.Second*3, assertionPollInterval, ctx).Should(Equal(competingNamespace.GetName()))
+
+						replicaStatus := getReplicaStatus(ctx, sourceObject, resource, ns)
+						Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateConflict))
+					}
+				}, testTimeout)
+
+				It("Should not adopt the same-named sources in the target namespaces", func(ctx SpecContext) {
+					Expect(k8sClient.Create(ctx, competingSourceObject)).To(Succeed())
+					setAnnotation(sourceObject, conflictPolicyAnnotationKey, string(v1alpha1.ConflictPolicyAdopt))
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					replicaStatus := getReplicaStatus(ctx, sourceObject, resource, competingNamespace)
+					Expect(replicaStatus.State).To(Equal(v1alpha1.ReplicaStateConflict))
+					Consistently(func() bool {
+						existingObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(competingSourceObject), existingObject)
+						if err != nil {
+							return false
+						}
+						_, hasSourceNamespace := existingObject.GetAnnotations()[sourceNamespaceAnnotationKey]
+						return existingObject.GetLabels()[objectTypeLabelKey] == objectTypeLabelValueReplicated &&
+							!hasSourceNamespace
+					}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+				}, testTimeout)
+
+				It("Should hand over the replicas once the source with precedence is deleted", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					setAnnotation(competingSourceObject, priorityAnnotationKey, "10")
+					Expect(k8sClient.Create(ctx, competingSourceObject)).To(Succeed())
+					validateSourceNamespace(ctx, sourceObject, resource, competingNamespace.GetName(), targetNamespaces...)
+
+					Expect(k8sClient.Delete(ctx, competingSourceObject)).To(Succeed())
+					validateSourceNamespace(ctx, sourceObject, resource, sourceNamespace.GetName(), targetNamespaces...)
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+				}, testTimeout)
+			})
+
+			Context("When remapping the names of replicas", func() {
+				BeforeEach(func(ctx SpecContext) {
+					setAnnotation(sourceObject, targetNameAnnotationKey, "{{.SourceNamespace}}-{{.Name}}")
+				})
+
+				It("Should replicate using the target name", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+					for _, ns := range targetNamespaces {
+						Expect(replicaKey(sourceObject, ns).Name).To(Equal(sourceNamespace.GetName() + "-" + sourceObject.GetName()))
+					}
+				}, testTimeout)
+
+				It("Should rename the replicas when the target name changes", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+					previousSourceObject := sourceObject.DeepCopyObject().(client.Object)
+
+					nameTemplate := "renamed-{{.Name}}"
+					Eventually(func() error {
+						latestSourceObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, client.ObjectKeyFromObject(sourceObject), latestSourceObject)
+						if err != nil {
+							return err
+						}
+						setAnnotation(latestSourceObject, targetNameAnnotationKey, nameTemplate)
+						return k8sClient.Update(ctx, latestSourceObject)
+					}, assertionTimeout, assertionPollInterval, ctx).Should(Succeed())
+					setAnnotation(sourceObject, targetNameAnnotationKey, nameTemplate)
+
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+					validateReplicaRemoval(ctx, previousSourceObject, resource, targetNamespaces...)
+				}, testTimeout)
+
+				It("Should remove the renamed replicas when the source is deleted", func(ctx SpecContext) {
+					targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+					Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+					validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+					Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+					validateReplicaRemoval(ctx, sourceObject, resource, targetNamespaces...)
+				}, testTimeout)
 			})
 		})
 	}
 })
 
+func validateSourceNamespace(ctx context.Context, sourceObject client.Object, resource testdata.Resource,
+	sourceNamespace string, targetNamespaces ...*corev1.Namespace) {
+	for _, ns := range targetNamespaces {
+		lookupKey := client.ObjectKey{Namespace: ns.GetName(), Name: sourceObject.GetName()}
+		Eventually(func() string {
+			replica := resource.EmptyObject()
+			err := k8sClient.Get(ctx, lookupKey, replica)
+			if err != nil {
+				return ""
+			}
+			return replica.GetAnnotations()[sourceNamespaceAnnotationKey]
+		}, assertionTimeout, assertionPollInterval, ctx).Should(Equal(sourceNamespace))
+	}
+}
+
+func getReplicaStatus(ctx context.Context, sourceObject client.Object, resource testdata.Resource,
+	ns *corev1.Namespace) v1alpha1.ReplicaStatus {
+	statusKey := client.ObjectKey{
+		Namespace: sourceObject.GetNamespace(),
+		Name:      replicationStatusName(resource.Name, sourceObject.GetName()),
+	}
+	var replicaStatus *v1alpha1.ReplicaStatus
+	Eventually(func() *v1alpha1.ReplicaStatus {
+		replicationStatus := &v1alpha1.ReplicationStatus{}
+		err := k8sClient.Get(ctx, statusKey, replicationStatus)
+		if err != nil {
+			return nil
+		}
+		replicaStatus = findReplicaStatus(replicationStatus.Status.Replicas, ns.GetName())
+		return replicaStatus
+	}, assertionTimeout, assertionPollInterval, ctx).ShouldNot(BeNil())
+	return *replicaStatus
+}
+
 type namespaceCreator struct {
 	testNamespaces []*corev1.Namespace
 }
@@ -205,12 +740,18 @@ func deleteNamespace(ctx context.Context, ns *corev1.Namespace) {
 	Expect(k8sClient.Delete(ctx, ns)).To(Succeed())
 }
 
+func replicaKey(sourceObject client.Object, ns *corev1.Namespace) client.ObjectKey {
+	replicaName, err := getReplicaName(sourceObject, ns.GetName())
+	Expect(err).NotTo(HaveOccurred())
+	return client.ObjectKey{
+		Namespace: ns.GetName(),
+		Name:      replicaName,
+	}
+}
+
 func validateNoReplication(ctx context.Context, sourceObject client.Object, resource testdata.Resource, targetNamespaces ...*corev1.Namespace) {
 	for _, ns := range targetNamespaces {
-		lookupKey := client.ObjectKey{
-			Namespace: ns.GetName(),
-			Name:      sourceObject.GetName(),
-		}
+		lookupKey := replicaKey(sourceObject, ns)
 		Consistently(func() bool {
 			err := k8sClient.Get(ctx, lookupKey, resource.EmptyObject())
 			return err != nil && errors.IsNotFound(err)
@@ -218,12 +759,19 @@ func validateNoReplication(ctx context.Context, sourceObject client.Object, reso
 	}
 }
 
+func validateReplicaRemoval(ctx context.Context, sourceObject client.Object, resource testdata.Resource, targetNamespaces ...*corev1.Namespace) {
+	for _, ns := range targetNamespaces {
+		lookupKey := replicaKey(sourceObject, ns)
+		Eventually(func() bool {
+			err := k8sClient.Get(ctx, lookupKey, resource.EmptyObject())
+			return err != nil && errors.IsNotFound(err)
+		}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+	}
+}
+
 func validateReplication(ctx context.Context, sourceObject client.Object, resource testdata.Resource, targetNamespaces ...*corev1.Namespace) {
 	for _, ns := range targetNamespaces {
-		lookupKey := client.ObjectKey{
-			Namespace: ns.GetName(),
-			Name:      sourceObject.GetName(),
-		}
+		lookupKey := replicaKey(sourceObject, ns)
 		Eventually(func() bool {
 			replicatedObject := resource.EmptyObject()
 			err := k8sClient.Get(ctx, lookupKey, replicatedObject)
@@ -238,6 +786,7 @@ func validateReplication(ctx context.Context, sourceObject client.Object, resour
 			sourceNamespace, sourceNamespaceOk := replicatedObject.GetAnnotations()[sourceNamespaceAnnotationKey]
 			Expect(sourceNamespaceOk).To(BeTrue())
 			Expect(sourceNamespace).To(Equal(sourceObject.GetNamespace()))
+			Expect(replicatedObject.GetAnnotations()).To(HaveKeyWithValue(sourceNameAnnotationKey, sourceObject.GetName()))
 
 			return isMapsEqualWithoutReplicatorKeys(sourceObject.GetLabels(), replicatedObject.GetLabels()) &&
 				isMapsEqualWithoutReplicatorKeys(sourceObject.GetAnnotations(), replicatedObject.GetAnnotations()) &&
@@ -260,3 +809,12 @@ func isMapsEqualWithoutReplicatorKeys(mapA map[string]string, mapB map[string]st
 	sanitizedMapB := removeReplicatorKeys(mapB)
 	return reflect.DeepEqual(sanitizedMapA, sanitizedMapB)
 }
+
+func setAnnotation(object client.Object, key string, value string) {
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[key] = value
+	object.SetAnnotations(annotations)
+}
@@ -0,0 +1,607 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	policyConditionReasonReplicated     = "Replicated"
+	policyConditionReasonInvalid        = "InvalidPolicy"
+	policyConditionReasonFailed         = "ReplicationFailed"
+	policyConditionReasonConflict       = ReplicaConflict
+	policyConditionMessageAllReplicated = "all sources replicated to the target namespaces"
+
+	// policySourceSyncInterval is the interval in which policies are re-synced when caching only the
+	// marked objects, as the changes to the source objects which are not marked are not watched
+	policySourceSyncInterval = 5 * time.Minute
+)
+
+// ReplicationPolicyReconciler reconciles a ReplicationPolicy object
+type ReplicationPolicyReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	recorder  record.EventRecorder
+	apiReader client.Reader
+
+	Replicators []replication.Replicator
+	// RegisteredReplicators holds the replicators of the kinds added at runtime (optional)
+	RegisteredReplicators *ReplicatorRegistry
+}
+
+// policySource is an object selected for replication by a policy
+type policySource struct {
+	object     client.Object
+	replicator replication.Replicator
+	keyFilter  replication.KeyFilter
+	transforms []replication.TransformSpec
+}
+
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicationpolicies,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicationpolicies/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicationpolicies/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ReplicationPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).V(1).WithValues("policyNamespace", req.Namespace,
+		"policyName", req.Name))
+	log.FromContext(ctx).V(2).Info("Reconciling replication policy")
+
+	policy := &v1alpha1.ReplicationPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		} else {
+			return ctrl.Result{}, fmt.Errorf("failed to get replication policy being reconciled: %+w", err)
+		}
+	}
+
+	if policy.GetDeletionTimestamp() != nil {
+		return ctrl.Result{}, r.handlePolicyRemoval(ctx, policy)
+	}
+	if err := r.handlePolicyUpdate(ctx, policy); err != nil {
+		return ctrl.Result{}, err
+	}
+	if cacheMarkedObjectsOnly {
+		return ctrl.Result{RequeueAfter: policySourceSyncInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *ReplicationPolicyReconciler) handlePolicyRemoval(ctx context.Context, policy *v1alpha1.ReplicationPolicy) error {
+	if !controllerutil.ContainsFinalizer(policy, resourceFinalizer) {
+		return nil
+	}
+
+	err := r.cleanupReplicas(ctx, policy, func(replicator replication.Replicator, replica client.Object) bool {
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finalize replication policy: %+w", err)
+	}
+	return removeFinalizer(ctx, r.Client, policy)
+}
+
+func (r *ReplicationPolicyReconciler) handlePolicyUpdate(ctx context.Context, policy *v1alpha1.ReplicationPolicy) error {
+	err := addFinalizer(ctx, r.Client, policy)
+	if err != nil {
+		return err
+	}
+
+	matcher, err := newNamespaceSelectionMatcher(policy.Spec.Targets)
+	if err != nil {
+		return r.handleInvalidPolicy(ctx, policy, err)
+	}
+	sources, err := r.resolveSources(ctx, policy)
+	if err != nil {
+		if isInvalidPolicyError(err) {
+			return r.handleInvalidPolicy(ctx, policy, err)
+		}
+		return err
+	}
+
+	// Guards the desired replicas and the replica statuses updated while replicating into the namespaces
+	lock := sync.Mutex{}
+	desiredReplicas := map[string]bool{}
+	replicaStatuses := make([][]v1alpha1.ReplicaStatus, len(sources))
+	conflictingReplicas := []string{}
+	replicationErr := iterateNamespaces(ctx, r.Client, func(ctx context.Context, ns corev1.Namespace) error {
+		if ns.GetName() == policy.GetNamespace() || !matcher.Matches(&ns) {
+			return nil
+		}
+
+		errs := []error{}
+		for i, source := range sources {
+			if replicaName, err := getReplicaName(source.object, ns.GetName()); err == nil {
+				lock.Lock()
+				desiredReplicas[policyReplicaKey(source.replicator, ns.GetName(), replicaName)] = true
+				lock.Unlock()
+			}
+
+			ctx := log.IntoContext(ctx, log.FromContext(ctx).WithValues("objectKind", source.replicator.GetKind(),
+				"sourceName", source.object.GetName()))
+			log.FromContext(ctx).V(1).Info("Creating/Updating replica", "replicaNamespace", ns.GetName())
+			err := replicateObject(ctx, r.Client, r.recorder, ns.GetName(), source.object, source.replicator,
+				replicaOptions{
+					policyName:     policy.GetName(),
+					conflictPolicy: policy.Spec.Options.ConflictPolicy,
+					keyFilter:      source.keyFilter,
+					transforms:     source.transforms,
+					apiReader:      r.apiReader,
+				})
+			lock.Lock()
+			replicaStatuses[i] = append(replicaStatuses[i], newReplicaStatus(ns.GetName(), source.object, err))
+			if isReplicaConflictError(err) {
+				conflictingReplicas = append(conflictingReplicas, fmt.Sprintf("%s %s/%s",
+					source.replicator.GetKind(), ns.GetName(), source.object.GetName()))
+			}
+			lock.Unlock()
+			if err := ignoreReplicaConflict(err); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("failed to replicate sources: %+v", errs)
+		}
+		return nil
+	})
+	statusErrs := []error{}
+	for i, source := range sources {
+		err := updateReplicationStatus(ctx, r.Client, r.apiReader, source.replicator.GetKind(), source.object,
+			setReplicaStatuses(replicaStatuses[i], false))
+		if err != nil {
+			statusErrs = append(statusErrs, err)
+		}
+	}
+	if replicationErr != nil {
+		r.reportFailure(ctx, policy, replicationErr)
+		return replicationErr
+	}
+	if len(statusErrs) > 0 {
+		return fmt.Errorf("failed to update replication statuses: %+v", statusErrs)
+	}
+
+	err = r.cleanupReplicas(ctx, policy, func(replicator replication.Replicator, replica client.Object) bool {
+		return desiredReplicas[policyReplicaKey(replicator, replica.GetNamespace(), replica.GetName())]
+	})
+	if err != nil {
+		r.reportFailure(ctx, policy, err)
+		return err
+	}
+	if len(conflictingReplicas) > 0 {
+		sort.Strings(conflictingReplicas)
+		return r.updateReadyCondition(ctx, policy, metav1.ConditionFalse, policyConditionReasonConflict,
+			fmt.Sprintf("replicas not created due to conflicting objects: %s", strings.Join(conflictingReplicas, ", ")))
+	}
+	return r.updateReadyCondition(ctx, policy, metav1.ConditionTrue, policyConditionReasonReplicated,
+		policyConditionMessageAllReplicated)
+}
+
+func (r *ReplicationPolicyReconciler) handleInvalidPolicy(ctx context.Context, policy *v1alpha1.ReplicationPolicy,
+	err error) error {
+	log.FromContext(ctx).Error(err, "Ignoring invalid replication policy")
+	r.recorder.Eventf(policy, "Warning", InvalidPolicy, "invalid replication policy: %v", err)
+	return r.updateReadyCondition(ctx, policy, metav1.ConditionFalse, policyConditionReasonInvalid, err.Error())
+}
+
+// resolveSources finds the objects selected by the policy in the namespace of the policy
+func (r *ReplicationPolicyReconciler) resolveSources(ctx context.Context, policy *v1alpha1.ReplicationPolicy) ([]policySource, error) {
+	replicators := withRegisteredReplicators(r.Replicators, r.RegisteredReplicators)
+	sources := []policySource{}
+	for i, sourceSelector := range policy.Spec.Sources {
+		replicator := findReplicator(replicators, sourceSelector.Kind)
+		if replicator == nil {
+			return nil, invalidPolicyError{fmt.Errorf("unsupported kind %s in source %d", sourceSelector.Kind, i)}
+		}
+		if (sourceSelector.Name == "") == (sourceSelector.Selector == nil) {
+			return nil, invalidPolicyError{fmt.Errorf("exactly one of name or selector should be specified in source %d", i)}
+		}
+		var keyFilter replication.KeyFilter
+		if len(sourceSelector.IncludeKeys) > 0 || len(sourceSelector.ExcludeKeys) > 0 {
+			if _, ok := replicator.(replication.KeyFilteringReplicator); !ok {
+				return nil, invalidPolicyError{fmt.Errorf("key filtering is not supported for kind %s in source %d",
+					sourceSelector.Kind, i)}
+			}
+			filter, err := replication.NewKeyFilter(sourceSelector.IncludeKeys, sourceSelector.ExcludeKeys)
+			if err != nil {
+				return nil, invalidPolicyError{fmt.Errorf("invalid keys in source %d: %+w", i, err)}
+			}
+			keyFilter = filter
+		}
+		transforms := toTransformSpecs(sourceSelector.Transforms)
+		if _, err := replication.NewTransformPipeline(replicator.EmptyObject(), transforms); err != nil {
+			return nil, invalidPolicyError{fmt.Errorf("invalid transforms in source %d: %+w", i, err)}
+		}
+
+		isAllowed, err := isSourceAllowed(ctx, r.Client, sourceSelector.Kind, policy.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if !isAllowed {
+			log.FromContext(ctx).V(1).Info("Ignoring source not allowed by cluster replication policies",
+				"objectKind", sourceSelector.Kind)
+			r.recorder.Eventf(policy, "Warning", SourceNotAllowed,
+				"replicating %s from namespace %s is not allowed by cluster replication policies",
+				sourceSelector.Kind, policy.GetNamespace())
+			continue
+		}
+
+		objects := []client.Object{}
+		if sourceSelector.Name != "" {
+			object := replicator.EmptyObject()
+			objectKey := client.ObjectKey{Namespace: policy.GetNamespace(), Name: sourceSelector.Name}
+			if err := r.sourceReader().Get(ctx, objectKey, object); err != nil {
+				if !errors.IsNotFound(err) {
+					return nil, fmt.Errorf("failed to get source %s %s: %+w", sourceSelector.Kind, sourceSelector.Name, err)
+				}
+			} else {
+				objects = append(objects, object)
+			}
+		} else {
+			selector, err := metav1.LabelSelectorAsSelector(sourceSelector.Selector)
+			if err != nil {
+				return nil, invalidPolicyError{fmt.Errorf("invalid selector in source %d: %+w", i, err)}
+			}
+			objectList := replicator.EmptyObjectList()
+			err = r.sourceReader().List(ctx, objectList, &client.ListOptions{
+				Namespace:     policy.GetNamespace(),
+				LabelSelector: selector,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list sources of kind %s: %+w", sourceSelector.Kind, err)
+			}
+			objects = replicator.ObjectListToArray(objectList)
+		}
+
+		for _, object := range objects {
+			if object.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if objectType, ok := object.GetLabels()[objectTypeLabelKey]; ok {
+				// Objects marked using labels are managed by the replication controller
+				log.FromContext(ctx).V(1).Info("Ignoring source object marked using labels",
+					"objectKind", sourceSelector.Kind, "sourceName", object.GetName(), "objectType", objectType)
+				continue
+			}
+			sources = append(sources, policySource{
+				object:     object,
+				replicator: replicator,
+				keyFilter:  keyFilter,
+				transforms: transforms,
+			})
+		}
+	}
+	return sources, nil
+}
+
+// sourceReader returns the reader of the source objects selected by the policies, which are not marked and
+// hence not cached when caching only the marked objects
+func (r *ReplicationPolicyReconciler) sourceReader() client.Reader {
+	if cacheMarkedObjectsOnly {
+		return r.apiReader
+	}
+	return r.Client
+}
+
+// cleanupReplicas removes the replicas managed by the policy for which isDesired returns false
+func (r *ReplicationPolicyReconciler) cleanupReplicas(ctx context.Context, policy *v1alpha1.ReplicationPolicy,
+	isDesired func(replicator replication.Replicator, replica client.Object) bool) error {
+	errs := []error{}
+	for _, replicator := range withRegisteredReplicators(r.Replicators, r.RegisteredReplicators) {
+		replicaList := replicator.EmptyObjectList()
+		err := r.List(ctx, replicaList, client.MatchingFields{
+			replicationPolicyIndexField: replicationPolicyIndexValue(policy.GetNamespace(), policy.GetName()),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list replicas of kind %s: %+w", replicator.GetKind(), err)
+		}
+
+		for _, replica := range replicator.ObjectListToArray(replicaList) {
+			if isDesired(replicator, replica) {
+				continue
+			}
+
+			ctx := log.IntoContext(ctx, log.FromContext(ctx).WithValues("objectKind", replicator.GetKind(),
+				"replicaNamespace", replica.GetNamespace(), "replicaName", replica.GetName()))
+			if policy.GetDeletionTimestamp() != nil && policy.Spec.Options.DeletionPolicy == v1alpha1.DeletionPolicyRetain {
+				log.FromContext(ctx).V(1).Info("Retaining replica as an unmanaged object")
+				err = releaseReplica(ctx, r.Client, replica)
+			} else {
+				log.FromContext(ctx).V(1).Info("Deleting replica", "reason", "source object not selected by policy")
+				err = deleteObject(ctx, r.Client, replicator.GetKind(), replica)
+				if err == nil {
+					r.recorder.Eventf(policy, "Normal", SourceObjectDelete, "replica %s of kind %s in namespace %s deleted",
+						replica.GetName(), replicator.GetKind(), replica.GetNamespace())
+				}
+			}
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			sourceObject := replicator.EmptyObject()
+			sourceObject.SetNamespace(policy.GetNamespace())
+			sourceObject.SetName(getReplicaSourceName(replica))
+			err = updateReplicationStatus(ctx, r.Client, r.apiReader, replicator.GetKind(), sourceObject,
+				removeReplicaStatus(replica.GetNamespace()))
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to cleanup replicas: %+v", errs)
+	}
+	return nil
+}
+
+// reportFailure records a replication failure in the policy status. The original error is returned
+// by the caller for requeuing, and hence failing to update the status is only logged.
+func (r *ReplicationPolicyReconciler) reportFailure(ctx context.Context, policy *v1alpha1.ReplicationPolicy, err error) {
+	statusErr := r.updateReadyCondition(ctx, policy, metav1.ConditionFalse, policyConditionReasonFailed, err.Error())
+	if statusErr != nil {
+		log.FromContext(ctx).Error(statusErr, "Failed to report replication failure in policy status")
+	}
+}
+
+func (r *ReplicationPolicyReconciler) updateReadyCondition(ctx context.Context, policy *v1alpha1.ReplicationPolicy,
+	status metav1.ConditionStatus, reason string, message string) error {
+	policy.Status.ObservedGeneration = policy.GetGeneration()
+	meta.SetStatusCondition(&policy.Status.Conditions, metav1.Condition{
+		Type:               v1alpha1.ReplicationPolicyConditionReady,
+		Status:             status,
+		ObservedGeneration: policy.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.Status().Update(ctx, policy); err != nil {
+		return fmt.Errorf("failed to update replication policy status: %+w", err)
+	}
+	return nil
+}
+
+// releaseReplica removes all the replicator metadata from a replica leaving it as an unmanaged object
+func releaseReplica(ctx context.Context, k8sClient client.Client, replica client.Object) error {
+	controllerutil.RemoveFinalizer(replica, resourceFinalizer)
+	replicaLabels := replica.GetLabels()
+	delete(replicaLabels, objectTypeLabelKey)
+	replica.SetLabels(replicaLabels)
+	replicaAnnotations := replica.GetAnnotations()
+	delete(replicaAnnotations, sourceNamespaceAnnotationKey)
+	delete(replicaAnnotations, sourceNameAnnotationKey)
+	delete(replicaAnnotations, replicationPolicyAnnotationKey)
+	replica.SetAnnotations(replicaAnnotations)
+
+	err := retryOperation(ctx, func() error {
+		return k8sClient.Update(ctx, replica)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release replica %s/%s: %+w", replica.GetNamespace(), replica.GetName(), err)
+	}
+	return nil
+}
+
+func policyReplicaKey(replicator replication.Replicator, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s", replicator.GetKind(), namespace, name)
+}
+
+func findReplicator(replicators []replication.Replicator, kind string) replication.Replicator {
+	for _, replicator := range replicators {
+		if replicator.GetKind() == kind {
+			return replicator
+		}
+	}
+	return nil
+}
+
+// invalidPolicyError is returned when a policy cannot be applied until it is corrected
+type invalidPolicyError struct {
+	error
+}
+
+func (e invalidPolicyError) Unwrap() error {
+	return e.error
+}
+
+func isInvalidPolicyError(err error) bool {
+	_, ok := err.(invalidPolicyError)
+	return ok
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ReplicationPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	name := "replicator-replicationpolicy-controller"
+	r.recorder = mgr.GetEventRecorderFor(name)
+	r.apiReader = mgr.GetAPIReader()
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Scheme == nil {
+		r.Scheme = mgr.GetScheme()
+	}
+	if err := setupReplicationStatusCache(mgr); err != nil {
+		return err
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha1.ReplicationPolicy{}).
+		Watches(&corev1.Namespace{}, r.namespaceEventHandler(),
+			builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Watches(&v1alpha1.ClusterReplicationPolicy{}, handler.EnqueueRequestsFromMapFunc(r.mapToAllPolicies))
+	for _, replicator := range r.Replicators {
+		controllerBuilder = controllerBuilder.Watches(replicator.EmptyObject(),
+			handler.EnqueueRequestsFromMapFunc(r.mapObjectToPolicies(replicator)))
+	}
+	return controllerBuilder.
+		WithOptions(newManagerOptions(mgr, name, "ReplicationPolicy")).
+		Complete(newTracingReconciler(name, r))
+}
+
+// NewUnmanagedSourceController creates a controller which is not started by the Manager, for re-evaluating
+// the policies selecting objects of a kind added at runtime
+func (r *ReplicationPolicyReconciler) NewUnmanagedSourceController(mgr ctrl.Manager,
+	replicator replication.Replicator) (controller.Controller, error) {
+	name := fmt.Sprintf("replicator-replicationpolicy-%s-controller", strings.ToLower(replicator.GetKind()))
+	options := newManagerOptions(mgr, name, "ReplicationPolicy")
+	options.Reconciler = newTracingReconciler(name, r)
+	options.SkipNameValidation = ptr.To(true) // The controller is re-created whenever the kind is updated
+	options.DefaultFromConfig(mgr.GetControllerOptions())
+	c, err := controller.NewUnmanaged(name, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller: %+w", err)
+	}
+
+	err = c.Watch(source.Kind(mgr.GetCache(), replicator.EmptyObject(),
+		handler.EnqueueRequestsFromMapFunc(r.mapObjectToPolicies(replicator))))
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch resources: %+w", err)
+	}
+	return c, nil
+}
+
+func (r *ReplicationPolicyReconciler) mapToAllPolicies(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.findPolicies(ctx, "", func(policy *v1alpha1.ReplicationPolicy) bool {
+		return true
+	})
+}
+
+// namespaceEventHandler maps the namespace events to the policies targeting the namespace before or after
+// the event, so that the policies no longer targeting a namespace remove their replicas from it as well
+func (r *ReplicationPolicyReconciler) namespaceEventHandler() handler.EventHandler {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			r.enqueueTargetingPolicies(ctx, q, e.Object)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			r.enqueueTargetingPolicies(ctx, q, e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			r.enqueueTargetingPolicies(ctx, q, e.Object)
+		},
+		GenericFunc: func(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			r.enqueueTargetingPolicies(ctx, q, e.Object)
+		},
+	}
+}
+
+// enqueueTargetingPolicies enqueues the policies targeting any of the given states of a namespace
+func (r *ReplicationPolicyReconciler) enqueueTargetingPolicies(ctx context.Context,
+	q workqueue.TypedRateLimitingInterface[reconcile.Request], namespaces ...client.Object) {
+	requests := r.findPolicies(ctx, "", func(policy *v1alpha1.ReplicationPolicy) bool {
+		matcher, err := newNamespaceSelectionMatcher(policy.Spec.Targets)
+		if err != nil {
+			return false // Invalid policies do not replicate into any namespace
+		}
+		for _, object := range namespaces {
+			ns, ok := object.(*corev1.Namespace)
+			if ok && ns.GetName() != policy.GetNamespace() && matcher.Matches(ns) {
+				return true
+			}
+		}
+		return false
+	})
+	for _, request := range requests {
+		q.Add(request)
+	}
+}
+
+// mapObjectToPolicies maps sources and replicas to the policies which manage them
+func (r *ReplicationPolicyReconciler) mapObjectToPolicies(replicator replication.Replicator) handler.MapFunc {
+	return func(ctx context.Context, object client.Object) []reconcile.Request {
+		if policyName, ok := object.GetAnnotations()[replicationPolicyAnnotationKey]; ok {
+			return []reconcile.Request{
+				{
+					NamespacedName: client.ObjectKey{
+						Namespace: object.GetAnnotations()[sourceNamespaceAnnotationKey],
+						Name:      policyName,
+					},
+				},
+			}
+		}
+		return r.findPolicies(ctx, object.GetNamespace(), func(policy *v1alpha1.ReplicationPolicy) bool {
+			return isSelectedByPolicy(policy, replicator.GetKind(), object)
+		})
+	}
+}
+
+// isSelectedByPolicy checks whether an object of a kind in the namespace of a policy is selected as a source
+func isSelectedByPolicy(policy *v1alpha1.ReplicationPolicy, kind string, object client.Object) bool {
+	for _, sourceSelector := range policy.Spec.Sources {
+		if sourceSelector.Kind != kind {
+			continue
+		}
+		if sourceSelector.Name == object.GetName() {
+			return true
+		}
+		if sourceSelector.Selector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(sourceSelector.Selector)
+			if err == nil && selector.Matches(labels.Set(object.GetLabels())) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *ReplicationPolicyReconciler) findPolicies(ctx context.Context, namespace string,
+	filter func(policy *v1alpha1.ReplicationPolicy) bool) []reconcile.Request {
+	policyList := &v1alpha1.ReplicationPolicyList{}
+	err := r.List(ctx, policyList, &client.ListOptions{
+		Namespace: namespace,
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list replication policies")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for i := range policyList.Items {
+		if filter(&policyList.Items[i]) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: client.ObjectKeyFromObject(&policyList.Items[i]),
+			})
+		}
+	}
+	return requests
+}
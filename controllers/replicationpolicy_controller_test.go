@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"github.com/nadundesilva/k8s-replicator/test/utils/testdata"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("Replication Policy", func() {
+	for _, resource := range testdata.GenerateResourceTestData() {
+		Describe(fmt.Sprintf("Resource %s", resource.Name), func() {
+			var sourceNamespace *corev1.Namespace
+			var sourceObject client.Object
+			var policy *v1alpha1.ReplicationPolicy
+			nc := namespaceCreator{}
+
+			BeforeEach(func(ctx SpecContext) {
+				sourceNamespace = &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "source-ns-" + uuid.New().String(),
+					},
+				}
+				Expect(k8sClient.Create(ctx, sourceNamespace)).To(Succeed())
+
+				sourceObject = resource.SourceObject()
+				sourceObject.SetNamespace(sourceNamespace.GetName())
+				delete(sourceObject.GetLabels(), objectTypeLabelKey)
+				Expect(k8sClient.Create(ctx, sourceObject)).To(Succeed())
+
+				policy = &v1alpha1.ReplicationPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-policy",
+						Namespace: sourceNamespace.GetName(),
+					},
+					Spec: v1alpha1.ReplicationPolicySpec{
+						Sources: []v1alpha1.SourceSelector{
+							{
+								Kind: resource.Name,
+								Name: sourceObject.GetName(),
+							},
+						},
+						Targets: v1alpha1.NamespaceSelection{
+							Namespaces: []string{"policy-target-*"},
+						},
+					},
+				}
+			})
+
+			AfterEach(func(ctx SpecContext) {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, policy))).To(Succeed())
+				policy = nil
+
+				Expect(k8sClient.Delete(ctx, sourceObject)).To(Succeed())
+				sourceObject = nil
+
+				deleteNamespace(ctx, sourceNamespace)
+				sourceNamespace = nil
+
+				nc.Cleanup(ctx)
+			})
+
+			It("Should replicate the sources to the target namespaces", func(ctx SpecContext) {
+				targetNamespaces := nc.CreateNamespaces(ctx, "policy-target", 3, nil)
+				otherNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+				Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+
+				validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+				validateNoReplication(ctx, sourceObject, resource, otherNamespaces...)
+
+				Eventually(func() bool {
+					latestPolicy := &v1alpha1.ReplicationPolicy{}
+					err := k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), latestPolicy)
+					if err != nil {
+						return false
+					}
+					return meta.IsStatusConditionTrue(latestPolicy.Status.Conditions, v1alpha1.ReplicationPolicyConditionReady)
+				}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+			}, testTimeout)
+
+			It("Should report the replicas conflicting with existing objects as not ready", func(ctx SpecContext) {
+				targetNamespaces := nc.CreateNamespaces(ctx, "policy-target", 2, nil)
+				conflictingObject := resource.SourceObject()
+				conflictingObject.SetNamespace(targetNamespaces[0].GetName())
+				delete(conflictingObject.GetLabels(), objectTypeLabelKey)
+				Expect(k8sClient.Create(ctx, conflictingObject)).To(Succeed())
+				Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+
+				validateReplication(ctx, sourceObject, resource, targetNamespaces[1])
+				Eventually(func() bool {
+					latestPolicy := &v1alpha1.ReplicationPolicy{}
+					err := k8sClient.Get(ctx, client.ObjectKeyFromObject(policy), latestPolicy)
+					if err != nil {
+						return false
+					}
+					condition := meta.FindStatusCondition(latestPolicy.Status.Conditions,
+						v1alpha1.ReplicationPolicyConditionReady)
+					return condition != nil && condition.Status == metav1.ConditionFalse &&
+						condition.Reason == ReplicaConflict
+				}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+			}, testTimeout)
+
+			It("Should replicate the sources to new target namespaces", func(ctx SpecContext) {
+				Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+				targetNamespaces := nc.CreateNamespaces(ctx, "policy-target", 2, nil)
+
+				validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+			}, testTimeout)
+
+			It("Should remove the replicas when the policy is deleted", func(ctx SpecContext) {
+				targetNamespaces := nc.CreateNamespaces(ctx, "policy-target", 2, nil)
+				Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+				validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+				Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+				validateReplicaRemoval(ctx, sourceObject, resource, targetNamespaces...)
+			}, testTimeout)
+
+			It("Should retain the replicas when the policy with retain deletion policy is deleted", func(ctx SpecContext) {
+				targetNamespaces := nc.CreateNamespaces(ctx, "policy-target", 2, nil)
+				policy.Spec.Options.DeletionPolicy = v1alpha1.DeletionPolicyRetain
+				Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+				validateReplication(ctx, sourceObject, resource, targetNamespaces...)
+
+				Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+				for _, ns := range targetNamespaces {
+					lookupKey := client.ObjectKey{Namespace: ns.GetName(), Name: sourceObject.GetName()}
+					Eventually(func() bool {
+						retainedObject := resource.EmptyObject()
+						err := k8sClient.Get(ctx, lookupKey, retainedObject)
+						if err != nil {
+							return false
+						}
+						_, isReplica := retainedObject.GetLabels()[objectTypeLabelKey]
+						return !isReplica && len(retainedObject.GetFinalizers()) == 0
+					}, assertionTimeout, assertionPollInterval, ctx).Should(BeTrue())
+				}
+			}, testTimeout)
+		})
+	}
+})
@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicationstatuses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=replicator.nadundesilva.github.io,resources=replicationstatuses/status,verbs=get;update;patch
+
+// replicaStatusUpdate modifies the replica statuses recorded for a source object
+type replicaStatusUpdate func(replicas []v1alpha1.ReplicaStatus) []v1alpha1.ReplicaStatus
+
+// replicationStatusNameHashLength is the length of the hash suffix of the truncated replication status names
+const replicationStatusNameHashLength = 10
+
+// replicationStatusName returns the name of the ReplicationStatus reporting the replicas of a source object.
+// Names longer than allowed are truncated, and suffixed with a hash of the full name to keep them unique.
+func replicationStatusName(kind string, sourceName string) string {
+	name := strings.ToLower(kind) + "-" + sourceName
+	if len(name) <= validation.DNS1123SubdomainMaxLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	truncatedName := strings.TrimRight(name[:validation.DNS1123SubdomainMaxLength-replicationStatusNameHashLength-1], "-.")
+	return truncatedName + "-" + hex.EncodeToString(hash[:])[:replicationStatusNameHashLength]
+}
+
+// newReplicaStatus builds the status of a replica based on the result of replicating the source object
+func newReplicaStatus(ns string, sourceObject client.Object, err error) v1alpha1.ReplicaStatus {
+	if err != nil {
+		state := v1alpha1.ReplicaStateFailed
+		if isReplicaConflictError(err) {
+			state = v1alpha1.ReplicaStateConflict
+		}
+		return v1alpha1.ReplicaStatus{
+			Namespace: ns,
+			State:     state,
+			Reason:    err.Error(),
+		}
+	}
+	now := metav1.Now()
+	return v1alpha1.ReplicaStatus{
+		Namespace:             ns,
+		State:                 v1alpha1.ReplicaStateSynced,
+		LastSyncTime:          &now,
+		SourceResourceVersion: sourceObject.GetResourceVersion(),
+	}
+}
+
+// newRetriedReplicaStatus builds the status of a replica which failed to sync, and is queued to be synced again
+func newRetriedReplicaStatus(ns string, err error) v1alpha1.ReplicaStatus {
+	return v1alpha1.ReplicaStatus{
+		Namespace: ns,
+		State:     v1alpha1.ReplicaStatePending,
+		Reason:    fmt.Sprintf("retrying after failure: %v", err),
+	}
+}
+
+// setReplicaStatuses returns an update which records the provided replica statuses. If replaceAll is
+// true, the statuses of all the other namespaces are removed.
+func setReplicaStatuses(statuses []v1alpha1.ReplicaStatus, replaceAll bool) replicaStatusUpdate {
+	return func(replicas []v1alpha1.ReplicaStatus) []v1alpha1.ReplicaStatus {
+		updated := []v1alpha1.ReplicaStatus{}
+		if !replaceAll {
+			for _, replica := range replicas {
+				if findReplicaStatus(statuses, replica.Namespace) == nil {
+					updated = append(updated, replica)
+				}
+			}
+		}
+		for _, status := range statuses {
+			existing := findReplicaStatus(replicas, status.Namespace)
+			if existing != nil && status.State != v1alpha1.ReplicaStateSynced {
+				// The last successful sync is retained for troubleshooting failures
+				status.LastSyncTime = existing.LastSyncTime
+				status.SourceResourceVersion = existing.SourceResourceVersion
+			}
+			if existing != nil && existing.State == v1alpha1.ReplicaStateSynced &&
+				status.State == v1alpha1.ReplicaStateSynced && existing.SourceResourceVersion == status.SourceResourceVersion {
+				// The replica was already synced with the same version of the source object
+				status.LastSyncTime = existing.LastSyncTime
+			}
+			updated = append(updated, status)
+		}
+		return updated
+	}
+}
+
+// removeReplicaStatus returns an update which removes the status of the replica in a namespace
+func removeReplicaStatus(ns string) replicaStatusUpdate {
+	return func(replicas []v1alpha1.ReplicaStatus) []v1alpha1.ReplicaStatus {
+		updated := []v1alpha1.ReplicaStatus{}
+		for _, replica := range replicas {
+			if replica.Namespace != ns {
+				updated = append(updated, replica)
+			}
+		}
+		return updated
+	}
+}
+
+func findReplicaStatus(replicas []v1alpha1.ReplicaStatus, ns string) *v1alpha1.ReplicaStatus {
+	for i := range replicas {
+		if replicas[i].Namespace == ns {
+			return &replicas[i]
+		}
+	}
+	return nil
+}
+
+// setupReplicationStatusCache starts caching the replication statuses, which are compared with the
+// updates before being written
+func setupReplicationStatusCache(mgr ctrl.Manager) error {
+	_, err := mgr.GetCache().GetInformer(context.Background(), &v1alpha1.ReplicationStatus{})
+	if err != nil {
+		return fmt.Errorf("failed to start informer of replication statuses: %+w", err)
+	}
+	return nil
+}
+
+// updateReplicationStatus applies an update to the ReplicationStatus of a source object. The
+// ReplicationStatus is created (owned by the source object) if it is not present. Updates which do not
+// change the cached status are not written, and otherwise the status is read directly from the API
+// server to avoid conflicts with stale cached statuses.
+func updateReplicationStatus(ctx context.Context, k8sClient client.Client, apiReader client.Reader,
+	kind string, sourceObject client.Object, update replicaStatusUpdate) error {
+	statusKey := client.ObjectKey{
+		Namespace: sourceObject.GetNamespace(),
+		Name:      replicationStatusName(kind, sourceObject.GetName()),
+	}
+	cachedStatus := &v1alpha1.ReplicationStatus{}
+	err := k8sClient.Get(ctx, statusKey, cachedStatus)
+	if err == nil && !isReplicaStatusesChanged(cachedStatus.Status.Replicas, update) {
+		return nil
+	} else if errors.IsNotFound(err) && len(update(nil)) == 0 {
+		return nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		replicationStatus := &v1alpha1.ReplicationStatus{}
+		err := apiReader.Get(ctx, statusKey, replicationStatus)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			replicas := update(nil)
+			if len(replicas) == 0 || sourceObject.GetUID() == "" {
+				return nil
+			}
+
+			replicationStatus = &v1alpha1.ReplicationStatus{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: statusKey.Namespace,
+					Name:      statusKey.Name,
+				},
+				Spec: v1alpha1.ReplicationStatusSpec{
+					Source: v1alpha1.SourceReference{
+						Kind: kind,
+						Name: sourceObject.GetName(),
+					},
+				},
+			}
+			err = controllerutil.SetOwnerReference(sourceObject, replicationStatus, k8sClient.Scheme())
+			if err != nil {
+				return fmt.Errorf("failed to set owner of replication status: %+w", err)
+			}
+			if err := k8sClient.Create(ctx, replicationStatus); err != nil {
+				return err
+			}
+		}
+
+		if !isReplicaStatusesChanged(replicationStatus.Status.Replicas, update) {
+			return nil
+		}
+		replicationStatus.Status.Replicas = applyReplicaStatusUpdate(replicationStatus.Status.Replicas, update)
+		return k8sClient.Status().Update(ctx, replicationStatus)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update replication status %s: %+w", statusKey, err)
+	}
+	log.FromContext(ctx).V(2).Info("Updated replication status", "replicationStatus", statusKey.Name)
+	return nil
+}
+
+func applyReplicaStatusUpdate(replicas []v1alpha1.ReplicaStatus, update replicaStatusUpdate) []v1alpha1.ReplicaStatus {
+	updated := update(replicas)
+	sort.Slice(updated, func(i, j int) bool {
+		return updated[i].Namespace < updated[j].Namespace
+	})
+	return updated
+}
+
+// isReplicaStatusesChanged checks whether an update changes the recorded replica statuses
+func isReplicaStatusesChanged(replicas []v1alpha1.ReplicaStatus, update replicaStatusUpdate) bool {
+	updated := applyReplicaStatusUpdate(replicas, update)
+	if len(updated) == 0 && len(replicas) == 0 {
+		return false
+	}
+	return !equality.Semantic.DeepEqual(updated, replicas)
+}
+
+// deleteReplicationStatus removes the ReplicationStatus of a source object which is no longer replicated
+func deleteReplicationStatus(ctx context.Context, k8sClient client.Client, kind string, sourceObject client.Object) error {
+	replicationStatus := &v1alpha1.ReplicationStatus{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: sourceObject.GetNamespace(),
+			Name:      replicationStatusName(kind, sourceObject.GetName()),
+		},
+	}
+	err := k8sClient.Delete(ctx, replicationStatus)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete replication status: %+w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var _ = Describe("Replication Status", func() {
+	It("Should name the replication status using the kind and the source name", func() {
+		Expect(replicationStatusName("ConfigMap", "test-configmap")).To(Equal("configmap-test-configmap"))
+	})
+
+	It("Should truncate the names of the replication statuses of sources with long names", func() {
+		sourceName := strings.Repeat("a", validation.DNS1123SubdomainMaxLength)
+		name := replicationStatusName("ConfigMap", sourceName)
+		Expect(validation.IsDNS1123Subdomain(name)).To(BeEmpty())
+		Expect(name).To(HavePrefix("configmap-aaaa"))
+
+		otherName := replicationStatusName("ConfigMap", sourceName[:len(sourceName)-1]+"b")
+		Expect(validation.IsDNS1123Subdomain(otherName)).To(BeEmpty())
+		Expect(otherName).NotTo(Equal(name))
+	})
+})
@@ -15,14 +15,18 @@ package controllers
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
 	"github.com/nadundesilva/k8s-replicator/controllers/replication"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gexec"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -34,11 +38,17 @@ import (
 	//+kubebuilder:scaffold:imports
 )
 
+const remoteClustersNamespace = "replicator-remote-clusters"
+
 var (
 	cfg       *rest.Config
 	k8sClient client.Client
 	testEnv   *envtest.Environment
 	cancel    context.CancelFunc
+
+	remoteK8sClient  client.Client
+	remoteKubeconfig []byte
+	remoteTestEnv    *envtest.Environment
 )
 
 func TestAPIs(t *testing.T) {
@@ -55,11 +65,13 @@ var _ = BeforeSuite(func() {
 	for _, replicator := range replicators {
 		Expect(replicator.AddToScheme(scheme)).NotTo(HaveOccurred())
 	}
+	Expect(v1alpha1.AddToScheme(scheme)).NotTo(HaveOccurred())
 
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
 		Scheme:                scheme,
-		ErrorIfCRDPathMissing: false,
+		CRDDirectoryPaths:     []string{filepath.Join("..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
 	}
 
 	var err error
@@ -73,8 +85,38 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient).NotTo(BeNil())
 
+	By("bootstrapping remote cluster test environment")
+	remoteTestEnv = &envtest.Environment{
+		Scheme: scheme,
+	}
+	remoteCfg, err := remoteTestEnv.Start()
+	Expect(err).NotTo(HaveOccurred())
+	remoteK8sClient, err = client.New(remoteCfg, client.Options{Scheme: scheme})
+	Expect(err).NotTo(HaveOccurred())
+	remoteUser, err := remoteTestEnv.AddUser(envtest.User{Name: "replicator", Groups: []string{"system:masters"}}, nil)
+	Expect(err).NotTo(HaveOccurred())
+	remoteKubeconfig, err = remoteUser.KubeConfig()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(k8sClient.Create(context.Background(), &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: remoteClustersNamespace,
+			Labels: map[string]string{
+				namespaceTypeLabelKey: namespaceTypeLabelValueIgnored,
+			},
+		},
+	})).To(Succeed())
+
+	// The settings read by the controllers are changed before the controllers are started
+	Expect(SetExcludedNamespaces([]string{"kube-*", "excluded-*"})).To(Succeed())
+	Expect(SetNamespaceParallelism(2)).To(Succeed())
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: scheme,
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				Unstructured: true,
+			},
+		},
 		Cache: cache.Options{
 			Scheme:                      scheme,
 			ReaderFailOnMissingInformer: true,
@@ -82,14 +124,37 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).ToNot(HaveOccurred())
 
+	remoteClusters := NewRemoteClusterRegistry(remoteClustersNamespace)
 	for _, replicator := range replicators {
 		err = (&ReplicationReconciler{
-			Replicator: replicator,
+			Replicator:     replicator,
+			RemoteClusters: remoteClusters,
 		}).SetupWithManager(mgr)
 		Expect(err).ToNot(HaveOccurred())
 	}
+	registeredReplicators := NewReplicatorRegistry()
 	err = (&NamespaceReconciler{
-		Replicators: replicators,
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
+	}).SetupWithManager(mgr)
+	Expect(err).ToNot(HaveOccurred())
+	policyReconciler := &ReplicationPolicyReconciler{
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
+	}
+	err = policyReconciler.SetupWithManager(mgr)
+	Expect(err).ToNot(HaveOccurred())
+	err = (&ReplicatedKindReconciler{
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
+		PolicyReconciler:      policyReconciler,
+		RemoteClusters:        remoteClusters,
+	}).SetupWithManager(mgr)
+	Expect(err).ToNot(HaveOccurred())
+	err = (&RemoteClusterReconciler{
+		Replicators:           replicators,
+		RegisteredReplicators: registeredReplicators,
+		RemoteClusters:        remoteClusters,
 	}).SetupWithManager(mgr)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -122,4 +187,8 @@ var _ = AfterSuite(func() {
 
 	err = testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
+
+	By("shutting down the remote cluster envtest environment")
+	err = remoteTestEnv.Stop()
+	Expect(err).NotTo(HaveOccurred())
 })
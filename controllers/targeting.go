@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const namespacePatternExclusionPrefix = "!"
+
+// namespaceMatcher decides whether a namespace is selected based on its labels and name. It is
+// used for deciding whether a namespace is a target of a source object.
+type namespaceMatcher struct {
+	selector        labels.Selector
+	includePatterns []string
+	excludePatterns []string
+}
+
+func newTargetNamespaceMatcher(sourceObject client.Object) (*namespaceMatcher, error) {
+	matcher := &namespaceMatcher{
+		selector: labels.Everything(),
+	}
+
+	if selectorString, ok := sourceObject.GetAnnotations()[targetNamespaceSelectorAnnotationKey]; ok {
+		selector, err := labels.Parse(selectorString)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s annotation %q: %+w",
+				targetNamespaceSelectorAnnotationKey, selectorString, err)
+		}
+		matcher.selector = selector
+	}
+
+	if patternsString, ok := sourceObject.GetAnnotations()[targetNamespacesAnnotationKey]; ok {
+		err := matcher.addNamespacePatterns(strings.Split(patternsString, ","))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s annotation %q: %+w",
+				targetNamespacesAnnotationKey, patternsString, err)
+		}
+	}
+	return matcher, nil
+}
+
+func newNamespaceSelectionMatcher(selection v1alpha1.NamespaceSelection) (*namespaceMatcher, error) {
+	matcher := &namespaceMatcher{
+		selector: labels.Everything(),
+	}
+
+	if selection.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(selection.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse namespace selector: %+w", err)
+		}
+		matcher.selector = selector
+	}
+
+	err := matcher.addNamespacePatterns(selection.Namespaces)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse namespaces: %+w", err)
+	}
+	return matcher, nil
+}
+
+// addNamespacePatterns adds namespace names or glob patterns to the matcher. Patterns prefixed
+// with "!" exclude the matching namespaces.
+func (m *namespaceMatcher) addNamespacePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		isExclusion := strings.HasPrefix(pattern, namespacePatternExclusionPrefix)
+		if isExclusion {
+			pattern = strings.TrimPrefix(pattern, namespacePatternExclusionPrefix)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid namespace pattern %q: %+w", pattern, err)
+		}
+
+		if isExclusion {
+			m.excludePatterns = append(m.excludePatterns, pattern)
+		} else {
+			m.includePatterns = append(m.includePatterns, pattern)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the namespace is selected by the matcher
+func (m *namespaceMatcher) Matches(ns *corev1.Namespace) bool {
+	if !m.selector.Matches(labels.Set(ns.GetLabels())) {
+		return false
+	}
+	if matchesAnyPattern(m.excludePatterns, ns.GetName()) {
+		return false
+	}
+	return len(m.includePatterns) == 0 || matchesAnyPattern(m.includePatterns, ns.GetName())
+}
+
+func matchesAnyPattern(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		// Patterns are validated when added to the matcher
+		if isMatch, _ := path.Match(pattern, name); isMatch {
+			return true
+		}
+	}
+	return false
+}
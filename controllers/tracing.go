@@ -0,0 +1,227 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.30.0"
+	"go.opentelemetry.io/otel/trace"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	tracerName  = "github.com/nadundesilva/k8s-replicator/controllers"
+	serviceName = "k8s-replicator"
+
+	namespaceAttributeKey = attribute.Key("k8s.namespace.name")
+	nameAttributeKey      = attribute.Key("k8s.object.name")
+	kindAttributeKey      = attribute.Key("k8s.object.kind")
+)
+
+// NewTracerProvider creates a tracer provider exporting the spans to an OTLP/HTTP endpoint
+// (e.g. http://otel-collector:4318). The tracer provider needs to be set as the global tracer provider
+// for the controllers to use it, and shut down to flush the remaining spans when the operator stops.
+func NewTracerProvider(ctx context.Context, endpoint string) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %+w", err)
+	}
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+	), nil
+}
+
+// startSpan starts a span using the global tracer provider. The tracer is looked up for each span,
+// so that the spans are exported using the tracer provider set when the span is started.
+func startSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// endSpan records the error (if any) in the span and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracingReconciler starts a span for each reconcile, and adds the trace ID to the logger of the
+// reconcile built using the log constructor of the controller
+type tracingReconciler struct {
+	name       string
+	reconciler reconcile.Reconciler
+}
+
+var _ reconcile.Reconciler = (*tracingReconciler)(nil)
+
+func newTracingReconciler(name string, reconciler reconcile.Reconciler) reconcile.Reconciler {
+	return &tracingReconciler{
+		name:       name,
+		reconciler: reconciler,
+	}
+}
+
+// Reconcile implements reconcile.Reconciler
+func (r *tracingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := startSpan(ctx, r.name+" Reconcile", namespaceAttributeKey.String(req.Namespace),
+		nameAttributeKey.String(req.Name))
+	if spanContext := span.SpanContext(); spanContext.IsValid() {
+		ctx = log.IntoContext(ctx, log.FromContext(ctx).WithValues(
+			"traceID", spanContext.TraceID().String(),
+			"spanID", spanContext.SpanID().String(),
+		))
+	}
+
+	result, err := r.reconciler.Reconcile(ctx, req)
+	endSpan(span, err)
+	return result, err
+}
+
+// tracingClient starts a span for each call made using the client
+type tracingClient struct {
+	client.Client
+}
+
+var _ client.Client = (*tracingClient)(nil)
+
+// NewTracingClient wraps a client to start a span for each call made using it
+func NewTracingClient(k8sClient client.Client) client.Client {
+	return &tracingClient{
+		Client: k8sClient,
+	}
+}
+
+// startCallSpan starts the span of a call made for an object (or a list of objects)
+func (c *tracingClient) startCallSpan(ctx context.Context, operation string, object client.Object,
+	list client.ObjectList) (context.Context, trace.Span) {
+	ctx, span := startSpan(ctx, operation)
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	if list != nil {
+		if gvk, err := c.GroupVersionKindFor(list); err == nil {
+			span.SetAttributes(kindAttributeKey.String(gvk.Kind))
+		}
+	} else {
+		if gvk, err := c.GroupVersionKindFor(object); err == nil {
+			span.SetAttributes(kindAttributeKey.String(gvk.Kind))
+		}
+		span.SetAttributes(namespaceAttributeKey.String(object.GetNamespace()),
+			nameAttributeKey.String(object.GetName()))
+	}
+	return ctx, span
+}
+
+// Get implements client.Reader
+func (c *tracingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object,
+	opts ...client.GetOption) error {
+	ctx, span := c.startCallSpan(ctx, "Get", obj, nil)
+	span.SetAttributes(namespaceAttributeKey.String(key.Namespace), nameAttributeKey.String(key.Name))
+	err := c.Client.Get(ctx, key, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// List implements client.Reader
+func (c *tracingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	ctx, span := c.startCallSpan(ctx, "List", nil, list)
+	err := c.Client.List(ctx, list, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// Create implements client.Writer
+func (c *tracingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	ctx, span := c.startCallSpan(ctx, "Create", obj, nil)
+	err := c.Client.Create(ctx, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// Delete implements client.Writer
+func (c *tracingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	ctx, span := c.startCallSpan(ctx, "Delete", obj, nil)
+	err := c.Client.Delete(ctx, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// Update implements client.Writer
+func (c *tracingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	ctx, span := c.startCallSpan(ctx, "Update", obj, nil)
+	err := c.Client.Update(ctx, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// Patch implements client.Writer
+func (c *tracingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch,
+	opts ...client.PatchOption) error {
+	ctx, span := c.startCallSpan(ctx, "Patch", obj, nil)
+	err := c.Client.Patch(ctx, obj, patch, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// DeleteAllOf implements client.Writer
+func (c *tracingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	ctx, span := c.startCallSpan(ctx, "DeleteAllOf", obj, nil)
+	err := c.Client.DeleteAllOf(ctx, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// Status implements client.StatusClient
+func (c *tracingClient) Status() client.SubResourceWriter {
+	return &tracingStatusWriter{
+		client:            c,
+		SubResourceWriter: c.Client.Status(),
+	}
+}
+
+// tracingStatusWriter starts a span for each status update made using the client
+type tracingStatusWriter struct {
+	client.SubResourceWriter
+	client *tracingClient
+}
+
+// Update implements client.SubResourceWriter
+func (w *tracingStatusWriter) Update(ctx context.Context, obj client.Object,
+	opts ...client.SubResourceUpdateOption) error {
+	ctx, span := w.client.startCallSpan(ctx, "UpdateStatus", obj, nil)
+	err := w.SubResourceWriter.Update(ctx, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// Patch implements client.SubResourceWriter
+func (w *tracingStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch,
+	opts ...client.SubResourcePatchOption) error {
+	ctx, span := w.client.startCallSpan(ctx, "PatchStatus", obj, nil)
+	err := w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+	endSpan(span, err)
+	return err
+}
@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracev1 "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// collectorStub is an in-process OTLP/HTTP collector holding the received spans
+type collectorStub struct {
+	lock  sync.Mutex
+	spans []*tracev1.Span
+}
+
+func (c *collectorStub) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	exportRequest := &collectortracev1.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(body, exportRequest); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, resourceSpans := range exportRequest.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			c.spans = append(c.spans, scopeSpans.GetSpans()...)
+		}
+	}
+	response, _ := proto.Marshal(&collectortracev1.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(response)
+}
+
+// findSpans finds the received spans with a name belonging to a trace
+func (c *collectorStub) findSpans(traceID trace.TraceID, name string) []*tracev1.Span {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	spans := []*tracev1.Span{}
+	for _, span := range c.spans {
+		if span.GetName() == name && trace.TraceID(span.GetTraceId()) == traceID {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+var _ = Describe("Tracing", func() {
+	nc := namespaceCreator{}
+
+	AfterEach(func(ctx SpecContext) {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		nc.Cleanup(ctx)
+	})
+
+	It("Should export a span per reconcile, namespace and call", func(ctx SpecContext) {
+		collector := &collectorStub{}
+		server := httptest.NewServer(collector)
+		defer server.Close()
+
+		tracerProvider, err := NewTracerProvider(ctx, server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		otel.SetTracerProvider(tracerProvider)
+		defer func() {
+			Expect(tracerProvider.Shutdown(context.Background())).To(Succeed())
+		}()
+		targetNamespaces := nc.CreateNamespaces(ctx, "test-ns", 2, nil)
+
+		tracingClient := NewTracingClient(k8sClient)
+		var traceID trace.TraceID
+		reconciler := newTracingReconciler("test-controller", reconcile.Func(
+			func(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+				traceID = trace.SpanContextFromContext(ctx).TraceID()
+				log.FromContext(ctx).Info("Reconciling")
+				return ctrl.Result{}, iterateNamespaces(ctx, tracingClient, func(ctx context.Context, ns corev1.Namespace) error {
+					return tracingClient.Get(ctx, client.ObjectKeyFromObject(&ns), &corev1.Namespace{})
+				})
+			}))
+
+		logs := []string{}
+		logger := funcr.New(func(prefix, args string) {
+			logs = append(logs, args)
+		}, funcr.Options{})
+		_, err = reconciler.Reconcile(log.IntoContext(ctx, logger), ctrl.Request{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(traceID.IsValid()).To(BeTrue())
+		Expect(logs).To(ContainElement(ContainSubstring(traceID.String())))
+		Expect(tracerProvider.ForceFlush(ctx)).To(Succeed())
+
+		reconcileSpans := collector.findSpans(traceID, "test-controller Reconcile")
+		Expect(reconcileSpans).To(HaveLen(1))
+		Expect(collector.findSpans(traceID, "List")).To(HaveLen(1))
+		namespaceSpans := collector.findSpans(traceID, "Namespace")
+		Expect(len(namespaceSpans)).To(BeNumerically(">=", len(targetNamespaces)))
+		for _, span := range namespaceSpans {
+			Expect(span.GetParentSpanId()).To(Equal(reconcileSpans[0].GetSpanId()))
+		}
+		Expect(collector.findSpans(traceID, "Get")).To(HaveLen(len(namespaceSpans)))
+	}, testTimeout)
+})
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nadundesilva/k8s-replicator/api/v1alpha1"
+	"github.com/nadundesilva/k8s-replicator/controllers/replication"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getSourceTransforms reads the transforms requested by a source object using the transforms
+// annotation (a JSON list of transform specifications)
+func getSourceTransforms(sourceObject client.Object) ([]replication.TransformSpec, error) {
+	transformsString, ok := sourceObject.GetAnnotations()[transformsAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+
+	transforms := []replication.TransformSpec{}
+	if err := json.Unmarshal([]byte(transformsString), &transforms); err != nil {
+		return nil, fmt.Errorf("failed to parse %s annotation: %+w", transformsAnnotationKey, err)
+	}
+	if _, err := replication.NewTransformPipeline(sourceObject, transforms); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %+w", transformsAnnotationKey, err)
+	}
+	return transforms, nil
+}
+
+// toTransformSpecs converts the transforms of a replication policy to transform specifications
+func toTransformSpecs(transforms []v1alpha1.Transform) []replication.TransformSpec {
+	specs := []replication.TransformSpec{}
+	for _, transform := range transforms {
+		specs = append(specs, replication.TransformSpec{
+			Type:   transform.Type,
+			Config: transform.Config,
+		})
+	}
+	return specs
+}
@@ -27,6 +27,7 @@ const (
 type ReportItem struct {
 	InitialNamespaceCount int    `json:"initialNamespaceCount"`
 	NewNamespaceCount     int    `json:"newNamespaceCount"`
+	NamespaceParallelism  int    `json:"namespaceParallelism,omitempty"`
 	Duration              string `json:"duration"`
 }
 
@@ -38,6 +39,9 @@ func (r ReportItems) Len() int {
 
 func (r ReportItems) Less(i, j int) bool {
 	if r[i].InitialNamespaceCount == r[j].InitialNamespaceCount {
+		if r[i].NewNamespaceCount == r[j].NewNamespaceCount {
+			return r[i].NamespaceParallelism < r[j].NamespaceParallelism
+		}
 		return r[i].NewNamespaceCount < r[j].NewNamespaceCount
 	} else {
 		return r[i].InitialNamespaceCount < r[j].InitialNamespaceCount
@@ -85,12 +89,14 @@ func (r Report) generateMarkdownReport() error {
 	}
 	content += "\n" +
 		"## Resource Creation\n\n" +
-		"This is a benchmark on replicating a new resource to namespaces with varying namespaces counts. The namespaces are " +
-		"created beforehand and only the time to replicate to the new namespaces are measured.\n\n" +
-		"| Namespace Count | Duration |\n" +
-		"| -- | -- |\n"
+		"This is a benchmark on replicating a new resource to namespaces with varying namespaces counts and the number of " +
+		"namespaces replicated into at once by a single reconcile (namespace parallelism). The namespaces are created " +
+		"beforehand and only the time to replicate to the new namespaces are measured.\n\n" +
+		"| Namespace Count | Namespace Parallelism | Duration |\n" +
+		"| -- | -- | -- |\n"
 	for _, reportItem := range r.resource {
-		content += fmt.Sprintf("| %d | %s |\n", reportItem.InitialNamespaceCount, reportItem.Duration)
+		content += fmt.Sprintf("| %d | %d | %s |\n", reportItem.InitialNamespaceCount, reportItem.NamespaceParallelism,
+			reportItem.Duration)
 	}
 
 	err := r.writeToFile(markdownReportPath, []byte(content))
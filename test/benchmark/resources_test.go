@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nadundesilva/k8s-replicator/controllers"
 	"github.com/nadundesilva/k8s-replicator/test/utils/cleanup"
 	"github.com/nadundesilva/k8s-replicator/test/utils/common"
 	"github.com/nadundesilva/k8s-replicator/test/utils/controller"
@@ -33,8 +34,11 @@ func TestResourceCreation(t *testing.T) {
 
 	resource := getBenchmarkTestData(t)
 	namespaceCounts := []int{1, 10, 100, 1000}
+	// Replicating into the namespaces one at a time shows the speedup of the default namespace parallelism
+	namespaceParallelisms := []int{1, controllers.DefaultNamespaceParallelism}
 
-	measureReplication := func(ctx context.Context, t *testing.T, cfg *envconf.Config, namespaceCount int) context.Context {
+	measureReplication := func(ctx context.Context, t *testing.T, cfg *envconf.Config, namespaceCount int,
+		namespaceParallelism int) context.Context {
 		startTime := time.Now()
 		resources.CreateObject(ctx, t, cfg, common.GetSourceObjectNamespace(ctx).GetName(), resource.SourceObject())
 		validation.ValidateReplication(ctx, t, cfg, resource.SourceObject(), resource.EmptyObjectList(),
@@ -44,33 +48,38 @@ func TestResourceCreation(t *testing.T) {
 		report.resource = append(report.resource, ReportItem{
 			InitialNamespaceCount: namespaceCount,
 			NewNamespaceCount:     0,
+			NamespaceParallelism:  namespaceParallelism,
 			Duration:              fmt.Sprint(duration),
 		})
 		return ctx
 	}
 
 	for _, namespaceCount := range namespaceCounts {
-		initialNamespaceCount := namespaceCount
-		featureName := fmt.Sprintf("resource creation with %d namespaces", initialNamespaceCount)
-		testFeatures = append(testFeatures, features.New(featureName).
-			Setup(func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
-				ctx = controller.SetupReplicator(ctx, t, cfg, controller.WithVerbosityLevel(controllerLogVerbosity))
-				ctx = namespaces.CreateSource(ctx, t, cfg)
-				for i := 0; i < initialNamespaceCount; i++ {
-					_, ctx = namespaces.CreateRandom(ctx, t, cfg)
-				}
-				return ctx
-			}).
-			Teardown(func(ctx context.Context, t *testing.T, c *envconf.Config) context.Context {
-				return cleanup.CleanTestObjectsWithOptions(ctx, t, c, cleanup.WithTimeout(time.Minute*5))
-			}).
-			Assess("replication time", func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
-				// Testing creating initial namespaces set
-				time.Sleep(time.Second * 30)
-				ctx = measureReplication(ctx, t, cfg, initialNamespaceCount)
-				return ctx
-			}).
-			Feature())
+		for _, namespaceParallelism := range namespaceParallelisms {
+			initialNamespaceCount := namespaceCount
+			featureName := fmt.Sprintf("resource creation with %d namespaces and namespace parallelism %d",
+				initialNamespaceCount, namespaceParallelism)
+			testFeatures = append(testFeatures, features.New(featureName).
+				Setup(func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+					ctx = controller.SetupReplicator(ctx, t, cfg, controller.WithVerbosityLevel(controllerLogVerbosity),
+						controller.WithArgs(fmt.Sprintf("--namespace-parallelism=%d", namespaceParallelism)))
+					ctx = namespaces.CreateSource(ctx, t, cfg)
+					for i := 0; i < initialNamespaceCount; i++ {
+						_, ctx = namespaces.CreateRandom(ctx, t, cfg)
+					}
+					return ctx
+				}).
+				Teardown(func(ctx context.Context, t *testing.T, c *envconf.Config) context.Context {
+					return cleanup.CleanTestObjectsWithOptions(ctx, t, c, cleanup.WithTimeout(time.Minute*5))
+				}).
+				Assess("replication time", func(ctx context.Context, t *testing.T, cfg *envconf.Config) context.Context {
+					// Testing creating initial namespaces set
+					time.Sleep(time.Second * 30)
+					ctx = measureReplication(ctx, t, cfg, initialNamespaceCount, namespaceParallelism)
+					return ctx
+				}).
+				Feature())
+		}
 	}
 
 	testenv.Test(t, testFeatures...)
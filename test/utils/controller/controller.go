@@ -130,6 +130,7 @@ func SetupReplicator(ctx context.Context, t *testing.T, cfg *envconf.Config, opt
 			if !foundLogDevelopmentModeFlag {
 				container.Args = append(container.Args, logDevelopmentModeFlag)
 			}
+			container.Args = append(container.Args, opts.args...)
 
 			deployment.Spec.Template.Spec.Containers[containerIndex] = container
 
@@ -15,6 +15,7 @@ package controller
 type Options struct {
 	labels       map[string]string
 	logVerbosity int
+	args         []string
 }
 
 type Option func(*Options)
@@ -32,3 +33,9 @@ func WithVerbosityLevel(logVerbosity int) Option {
 		options.logVerbosity = logVerbosity
 	}
 }
+
+func WithArgs(args ...string) Option {
+	return func(options *Options) {
+		options.args = append(options.args, args...)
+	}
+}
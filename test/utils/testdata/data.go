@@ -80,6 +80,10 @@ func GenerateResourceTestData() []Resource {
 		generateServiceAccountTestDatum(),
 		generateRoleTestDatum(),
 		generateRoleBindingTestDatum(),
+		generateLimitRangeTestDatum(),
+		generateResourceQuotaTestDatum(),
+		generatePodDisruptionBudgetTestDatum(),
+		generateHorizontalPodAutoscalerTestDatum(),
 	}
 	filteredResources := []Resource{}
 	for _, resource := range resources {
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func generateHorizontalPodAutoscalerTestDatum() Resource {
+	return process(resourceData{
+		Name: "HorizontalPodAutoscaler",
+		SourceObject: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("test-horizontal-pod-autoscaler-%s", uuid.New().String()),
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       "test-deployment-one",
+				},
+				MinReplicas: ptr.To[int32](2),
+				MaxReplicas: 10,
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{
+								Type:               autoscalingv2.UtilizationMetricType,
+								AverageUtilization: ptr.To[int32](75),
+							},
+						},
+					},
+				},
+			},
+		},
+		SourceObjectUpdate: &autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       "test-stateful-set-two",
+				},
+				MinReplicas: ptr.To[int32](1),
+				MaxReplicas: 5,
+				Metrics: []autoscalingv2.MetricSpec{
+					{
+						Type: autoscalingv2.ResourceMetricSourceType,
+						Resource: &autoscalingv2.ResourceMetricSource{
+							Name: corev1.ResourceMemory,
+							Target: autoscalingv2.MetricTarget{
+								Type:         autoscalingv2.AverageValueMetricType,
+								AverageValue: ptr.To(resource.MustParse("512Mi")),
+							},
+						},
+					},
+				},
+				Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+					ScaleDown: &autoscalingv2.HPAScalingRules{
+						StabilizationWindowSeconds: ptr.To[int32](600),
+						SelectPolicy:               ptr.To(autoscalingv2.MinChangePolicySelect),
+						Policies: []autoscalingv2.HPAScalingPolicy{
+							{
+								Type:          autoscalingv2.PodsScalingPolicy,
+								Value:         1,
+								PeriodSeconds: 120,
+							},
+						},
+					},
+				},
+			},
+		},
+		EmptyObject:     &autoscalingv2.HorizontalPodAutoscaler{},
+		EmptyObjectList: &autoscalingv2.HorizontalPodAutoscalerList{},
+		IsEqual: func(sourceObject client.Object, replicaObject client.Object) bool {
+			sourceHorizontalPodAutoscaler := sourceObject.(*autoscalingv2.HorizontalPodAutoscaler)
+			replicaHorizontalPodAutoscaler := replicaObject.(*autoscalingv2.HorizontalPodAutoscaler)
+			return equality.Semantic.DeepEqual(sourceHorizontalPodAutoscaler.Spec, replicaHorizontalPodAutoscaler.Spec)
+		},
+	})
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func generateLimitRangeTestDatum() Resource {
+	return process(resourceData{
+		Name: "LimitRange",
+		SourceObject: &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("test-limit-range-%s", uuid.New().String()),
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{
+					{
+						Type: corev1.LimitTypeContainer,
+						Default: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("512Mi"),
+						},
+						DefaultRequest: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("100m"),
+							corev1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+						Max: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("2Gi"),
+						},
+					},
+				},
+			},
+		},
+		SourceObjectUpdate: &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{
+					{
+						Type: corev1.LimitTypeContainer,
+						Default: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						DefaultRequest: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("250m"),
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+					{
+						Type: corev1.LimitTypePersistentVolumeClaim,
+						Min: corev1.ResourceList{
+							corev1.ResourceStorage: resource.MustParse("1Gi"),
+						},
+						Max: corev1.ResourceList{
+							corev1.ResourceStorage: resource.MustParse("10Gi"),
+						},
+					},
+				},
+			},
+		},
+		EmptyObject:     &corev1.LimitRange{},
+		EmptyObjectList: &corev1.LimitRangeList{},
+		IsEqual: func(sourceObject client.Object, replicaObject client.Object) bool {
+			sourceLimitRange := sourceObject.(*corev1.LimitRange)
+			replicaLimitRange := replicaObject.(*corev1.LimitRange)
+			return equality.Semantic.DeepEqual(sourceLimitRange.Spec, replicaLimitRange.Spec)
+		},
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func generatePodDisruptionBudgetTestDatum() Resource {
+	return process(resourceData{
+		Name: "PodDisruptionBudget",
+		SourceObject: &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("test-pod-disruption-budget-%s", uuid.New().String()),
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MinAvailable: ptr.To(intstr.FromInt(1)),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"pod-disruption-budget-one-selector-label-key": "pod-disruption-budget-one-selector-label-value",
+					},
+				},
+			},
+		},
+		SourceObjectUpdate: &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MaxUnavailable: ptr.To(intstr.FromString("25%")),
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{
+							Key:      "pod-disruption-budget-two-selector-label-key",
+							Operator: metav1.LabelSelectorOpIn,
+							Values: []string{
+								"pod-disruption-budget-two-selector-label-value-1",
+								"pod-disruption-budget-two-selector-label-value-2",
+							},
+						},
+					},
+				},
+				UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+			},
+		},
+		EmptyObject:     &policyv1.PodDisruptionBudget{},
+		EmptyObjectList: &policyv1.PodDisruptionBudgetList{},
+		IsEqual: func(sourceObject client.Object, replicaObject client.Object) bool {
+			sourcePodDisruptionBudget := sourceObject.(*policyv1.PodDisruptionBudget)
+			replicaPodDisruptionBudget := replicaObject.(*policyv1.PodDisruptionBudget)
+			return equality.Semantic.DeepEqual(sourcePodDisruptionBudget.Spec, replicaPodDisruptionBudget.Spec)
+		},
+	})
+}
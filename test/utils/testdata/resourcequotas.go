@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025, Nadun De Silva. All Rights Reserved.
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *   http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package testdata
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func generateResourceQuotaTestDatum() Resource {
+	return process(resourceData{
+		Name: "ResourceQuota",
+		SourceObject: &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("test-resource-quota-%s", uuid.New().String()),
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{
+					corev1.ResourceRequestsCPU:    resource.MustParse("4"),
+					corev1.ResourceRequestsMemory: resource.MustParse("8Gi"),
+					corev1.ResourcePods:           resource.MustParse("20"),
+				},
+			},
+		},
+		SourceObjectUpdate: &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					"e2e-tests.replicator.io/test-label-key": "test-label-value",
+				},
+				Annotations: map[string]string{
+					"e2e-tests.replicator.io/test-annotation-key": "test-annotation-value",
+				},
+			},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{
+					corev1.ResourceLimitsCPU:      resource.MustParse("8"),
+					corev1.ResourceLimitsMemory:   resource.MustParse("16Gi"),
+					corev1.ResourceRequestsMemory: resource.MustParse("12Gi"),
+					corev1.ResourcePods:           resource.MustParse("40"),
+				},
+				Scopes: []corev1.ResourceQuotaScope{
+					corev1.ResourceQuotaScopeNotTerminating,
+				},
+			},
+		},
+		EmptyObject:     &corev1.ResourceQuota{},
+		EmptyObjectList: &corev1.ResourceQuotaList{},
+		IsEqual: func(sourceObject client.Object, replicaObject client.Object) bool {
+			sourceResourceQuota := sourceObject.(*corev1.ResourceQuota)
+			replicaResourceQuota := replicaObject.(*corev1.ResourceQuota)
+			return equality.Semantic.DeepEqual(sourceResourceQuota.Spec, replicaResourceQuota.Spec)
+		},
+	})
+}